@@ -0,0 +1,60 @@
+package xlsxtra
+
+import (
+	"fmt"
+
+	"github.com/tealeg/xlsx"
+)
+
+// Area is a range of cells of a sheet. Ref is the range
+// without the sheet, such as "A1:C4".
+type Area struct {
+	Sheet *Sheet
+	Ref   string
+	Cells [][]*xlsx.Cell
+}
+
+// Range returns the areas of a reference, such as
+// "'Q1 Data'!A1:B9", "Sheet1!A:C,Sheet1!2:5" or a workbook
+// defined name. Every area is returned with its cells by row
+// (see Sheet.CellRange). An area without a sheet belongs to
+// the sheet of the previous area.
+func (f *File) Range(ref string) ([]Area, error) {
+	if target, ok := lookupName(f.File, ref, ""); ok {
+		ref = target
+	}
+	var areas []Area
+	var sheet *Sheet
+	for _, part := range splitAreas(ref) {
+		name, rg := splitSheetRef(part)
+		if name != "" {
+			var err error
+			sheet, err = f.SheetByName(name)
+			if err != nil {
+				return nil, fmt.Errorf("Range: %v", err)
+			}
+		}
+		if sheet == nil {
+			return nil, fmt.Errorf("Range: sheet missing: %w",
+				&CoordError{Ref: part, Range: true})
+		}
+		rg, err := sheet.resolve(rg)
+		if err != nil {
+			return nil, fmt.Errorf("Range: %w", err)
+		}
+		cells, err := sheet.CellRange(rg)
+		if err != nil {
+			return nil, fmt.Errorf("Range: %w", err)
+		}
+		area := Area{Sheet: sheet, Ref: rg, Cells: cells}
+		if len(cells) > 0 {
+			minCol, minRow, maxCol, maxRow, err := sheet.Bounds(rg)
+			if err != nil {
+				return nil, fmt.Errorf("Range: %w", err)
+			}
+			area.Ref = rangeRef(minCol, minRow, maxCol, maxRow)
+		}
+		areas = append(areas, area)
+	}
+	return areas, nil
+}
@@ -0,0 +1,80 @@
+package xlsxtra_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func newFileRange() *xlsxtra.File {
+	f := xlsxtra.NewFile()
+	for _, name := range []string{"Q1 Data", "Bob's, Inc"} {
+		sheet, err := f.AddSheet(name)
+		if err != nil {
+			panic(err)
+		}
+		for r := 1; r <= 4; r++ {
+			sheet.AddRow().AddInt(r, r*10, r*100)
+		}
+	}
+	return f
+}
+
+func ExampleFile_Range() {
+	f := newFileRange()
+	areas, err := f.Range("'Q1 Data'!B:C,3:9")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, area := range areas {
+		fmt.Print(area.Sheet.Name, "!", area.Ref, ":")
+		for _, row := range area.Cells {
+			fmt.Print(" ", xlsxtra.ToString(row))
+		}
+		fmt.Println()
+	}
+	// Output:
+	// Q1 Data!B1:C4: [10 100] [20 200] [30 300] [40 400]
+	// Q1 Data!A3:C4: [3 30 300] [4 40 400]
+}
+
+func TestFile_Range(t *testing.T) {
+	f := newFileRange()
+	areas, err := f.Range("'Bob''s, Inc'!A1:B2,'Q1 Data'!$C$4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(areas) != 2 || areas[0].Sheet.Name != "Bob's, Inc" ||
+		areas[1].Ref != "C4" || areas[1].Cells[0][0].Value != "400" {
+		t.Fatalf("Range: got %+v", areas)
+	}
+	f, err = withNames(f,
+		`<definedName name="Totals">'Q1 Data'!C1:C4</definedName>`,
+		`<definedName name="Last" localSheetId="1">$C$4</definedName>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	areas, err = f.Range("Totals")
+	if err != nil || len(areas[0].Cells) != 4 {
+		t.Fatalf("Range: got %+v, %v", areas, err)
+	}
+	// a name local to the sheet
+	areas, err = f.Range("'Bob''s, Inc'!Last")
+	if err != nil || areas[0].Ref != "C4" ||
+		areas[0].Cells[0][0].Value != "400" {
+		t.Fatalf("Range: got %+v, %v", areas, err)
+	}
+	for _, ref := range []string{"A1:B2", "'Q1 Data'!A1:Z1",
+		"Missing!A1", "'Q1 Data'!A1,B"} {
+		if _, err = f.Range(ref); err == nil {
+			t.Errorf("Range(%q): expected error", ref)
+		}
+	}
+	_, err = f.Range("'Q1 Data'!A1:Z1")
+	if !errors.Is(err, xlsxtra.ErrOutOfRange) {
+		t.Fatalf("Range: got %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+package xlsxtra
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/tealeg/xlsx"
+)
+
+const (
+	defaultFontSize  = 11.0 // excel default font size
+	defaultRowHeight = 15.0 // points for the default font
+	maxColWidth      = 255.0
+)
+
+// colBounds converts a column name ("B") or a range of
+// column names ("B:D") into one based boundaries.
+func colBounds(cols string) (int, int, error) {
+	parts := strings.SplitN(cols, ":", 2)
+	if len(parts) == 1 {
+		parts = append(parts, parts[0])
+	}
+	first, ok1 := StrCol[strings.TrimPrefix(parts[0], "$")]
+	last, ok2 := StrCol[strings.TrimPrefix(parts[1], "$")]
+	if !ok1 || !ok2 || first > last {
+		return 0, 0, &CoordError{Ref: cols, Range: true}
+	}
+	return first, last, nil
+}
+
+// SetColWidth sets the width (in characters) of a column
+// ("B") or a range of columns ("B:D").
+func (sheet *Sheet) SetColWidth(cols string,
+	width float64) error {
+	first, last, err := colBounds(cols)
+	if err != nil {
+		return fmt.Errorf("SetColWidth: %w",
+			withSheet(err, sheet.Name))
+	}
+	for c := first; c <= last; c++ {
+		sheet.col(c - 1).Width = width
+	}
+	return nil
+}
+
+// SetRowHeight sets the height (in points) of a one based
+// row.
+func (sheet *Sheet) SetRowHeight(row int,
+	height float64) error {
+	if row < 1 || row > len(sheet.Rows) {
+		return fmt.Errorf("SetRowHeight: %w", &RangeError{
+			Sheet:  sheet.Name,
+			Coord:  Coord(1, row),
+			Row:    row,
+			Col:    1,
+			MaxRow: len(sheet.Rows),
+		})
+	}
+	sheet.Row(row).SetHeight(height)
+	return nil
+}
+
+// AutoFit sets the width of columns ("B" or "B:D") to fit
+// their formatted cell values, taking the font size and
+// boldness of the cell style into account. Without columns,
+// all columns are fitted. Columns without values and cells
+// with wrapped text are ignored.
+func (sheet *Sheet) AutoFit(cols ...string) error {
+	if len(cols) == 0 {
+		n := sheet.maxCol()
+		if n == 0 {
+			return nil
+		}
+		cols = []string{"A:" + ColStr[n]}
+	}
+	for _, rg := range cols {
+		first, last, err := colBounds(rg)
+		if err != nil {
+			return fmt.Errorf("AutoFit: %w",
+				withSheet(err, sheet.Name))
+		}
+		for c := first; c <= last; c++ {
+			width := 0.0
+			for _, row := range sheet.Rows {
+				if c > len(row.Cells) {
+					continue
+				}
+				cell := row.Cells[c-1]
+				if cell.Value == "" ||
+					cell.GetStyle().Alignment.WrapText {
+					continue
+				}
+				scale := fontScale(cell)
+				for _, line := range lines(cell) {
+					width = math.Max(width, textWidth(line)*scale)
+				}
+			}
+			if width > 0 {
+				sheet.col(c - 1).Width = math.Min(width+2,
+					maxColWidth)
+			}
+		}
+	}
+	return nil
+}
+
+// AutoHeight sets the height of one based rows to fit the
+// lines of cells with wrapped text (see xlsx.Alignment). Rows
+// without wrapped text are not changed. Without rows, all
+// rows are fitted.
+func (sheet *Sheet) AutoHeight(rows ...int) error {
+	if len(rows) == 0 {
+		for r := range sheet.Rows {
+			rows = append(rows, r+1)
+		}
+	}
+	for _, r := range rows {
+		if r < 1 || r > len(sheet.Rows) {
+			return fmt.Errorf("AutoHeight: %w", &RangeError{
+				Sheet:  sheet.Name,
+				Coord:  Coord(1, r),
+				Row:    r,
+				Col:    1,
+				MaxRow: len(sheet.Rows),
+			})
+		}
+		height := 0.0
+		for c, cell := range sheet.Rows[r-1].Cells {
+			if cell.Value == "" ||
+				!cell.GetStyle().Alignment.WrapText {
+				continue
+			}
+			width := sheet.col(c).Width
+			if width == 0 {
+				width = xlsx.ColWidth
+			}
+			scale := fontScale(cell)
+			n := 0.0
+			for _, line := range lines(cell) {
+				n += math.Max(1,
+					math.Ceil(textWidth(line)*scale/width))
+			}
+			height = math.Max(height, n*defaultRowHeight*scale)
+		}
+		if height > 0 {
+			sheet.Rows[r-1].SetHeight(height)
+		}
+	}
+	return nil
+}
+
+// col returns the column of a zero based index. Missing
+// columns are added. A loaded sheet has an entry per column
+// which keeps the range of its <col> element (or no range at
+// all), so every entry is limited to its own column to avoid
+// overlapping <col> elements when the sheet is saved. (Column
+// ranges beyond the used columns are therefore dropped.)
+func (sheet *Sheet) col(i int) *xlsx.Col {
+	for j, col := range sheet.Cols {
+		col.Min, col.Max = j+1, j+1
+	}
+	for len(sheet.Cols) <= i {
+		n := len(sheet.Cols) + 1
+		col := &xlsx.Col{Min: n, Max: n}
+		col.SetStyle(xlsx.NewStyle())
+		sheet.Cols = append(sheet.Cols, col)
+	}
+	if sheet.MaxCol < len(sheet.Cols) {
+		sheet.MaxCol = len(sheet.Cols)
+	}
+	return sheet.Cols[i]
+}
+
+// maxCol returns the number of cells of the longest row
+func (sheet *Sheet) maxCol() int {
+	n := 0
+	for _, row := range sheet.Rows {
+		if len(row.Cells) > n {
+			n = len(row.Cells)
+		}
+	}
+	return n
+}
+
+// lines returns the lines of the formatted value of a cell
+func lines(cell *xlsx.Cell) []string {
+	s, err := cell.FormattedValue()
+	if err != nil {
+		s = cell.Value
+	}
+	return strings.Split(s, "\n")
+}
+
+// fontScale returns the width of the font of a cell relative
+// to the default font.
+func fontScale(cell *xlsx.Cell) float64 {
+	style := cell.GetStyle()
+	scale := 1.0
+	if style.ApplyFont {
+		if style.Font.Size > 0 {
+			scale = float64(style.Font.Size) / defaultFontSize
+		}
+		if style.Font.Bold {
+			scale *= 1.1
+		}
+	}
+	return scale
+}
+
+// textWidth estimates the width of a text in characters of
+// the default font. East Asian characters count double.
+func textWidth(s string) float64 {
+	w := 0.0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hangul,
+			unicode.Hiragana, unicode.Katakana):
+			w += 2
+		case unicode.IsUpper(r):
+			w += 1.2
+		default:
+			w++
+		}
+	}
+	return w
+}
@@ -0,0 +1,177 @@
+package xlsxtra_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+	"github.com/tealeg/xlsx"
+)
+
+func ExampleSheet_AutoFit() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Report")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	header := sheet.AddRow()
+	header.AddString("name", "description")
+	header.SetStyle(xlsxtra.NewStyle("",
+		&xlsx.Font{Size: 22, Name: "Arial", Bold: true}, nil, nil))
+	sheet.AddRow().AddString("cookies", "chocolate chip cookies")
+	err = sheet.AutoFit()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, col := range sheet.Cols {
+		fmt.Printf("%.1f\n", col.Width)
+	}
+	// Output:
+	// 10.8
+	// 26.2
+}
+
+func TestSheet_SetColWidth(t *testing.T) {
+	sheet := newSheetUtils()
+	err := sheet.SetColWidth("A:C", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sheet.Cols) != 3 || sheet.Cols[2].Width != 20 {
+		t.Fatalf("SetColWidth: got %d columns", len(sheet.Cols))
+	}
+	if err = sheet.SetColWidth("F", 5); err != nil {
+		t.Fatal(err)
+	}
+	if len(sheet.Cols) != 6 || sheet.Cols[5].Width != 5 {
+		t.Fatalf("SetColWidth: got %d columns", len(sheet.Cols))
+	}
+	for _, cols := range []string{"C:A", "1", "A:"} {
+		err = sheet.SetColWidth(cols, 1)
+		if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+			t.Fatalf("SetColWidth(%q): got %v", cols, err)
+		}
+	}
+	if err = sheet.AutoFit("0"); err == nil {
+		t.Fatal("AutoFit: expected error")
+	}
+	if err = sheet.SetRowHeight(2, 30); err != nil {
+		t.Fatal(err)
+	}
+	if sheet.Rows[1].Height != 30 {
+		t.Fatalf("SetRowHeight: got %v", sheet.Rows[1].Height)
+	}
+	err = sheet.SetRowHeight(3, 30)
+	if !errors.Is(err, xlsxtra.ErrOutOfRange) {
+		t.Fatalf("SetRowHeight: got %v", err)
+	}
+}
+
+func TestSheet_AutoHeight(t *testing.T) {
+	sheet := newSheetUtils()
+	wrap := xlsxtra.NewStyle("", nil, nil,
+		&xlsx.Alignment{WrapText: true})
+	cell := sheet.Row(2).Cells[1]
+	cell.SetString(strings.Repeat("word ", 5) + "\nend")
+	cell.SetStyle(wrap)
+	if err := sheet.SetColWidth("B", 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := sheet.AutoHeight(); err != nil {
+		t.Fatal(err)
+	}
+	if h := sheet.Rows[1].Height; h != 60 {
+		t.Fatalf("AutoHeight: got %v; want 60 (4 lines)", h)
+	}
+	if h := sheet.Rows[0].Height; h != 0 {
+		t.Fatalf("AutoHeight: got %v; want unchanged", h)
+	}
+	if err := sheet.AutoHeight(5); err == nil {
+		t.Fatal("AutoHeight: expected error")
+	}
+}
+
+func TestSheet_SetColWidth_loaded(t *testing.T) {
+	f, err := xlsxtra.OpenFile("xlsxtra_test.xlsx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"sheet_test.go", "sort_test.go"} {
+		sheet, err := f.SheetByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		maxCol := sheet.MaxCol
+		if err = sheet.SetColWidth("B", 30); err != nil {
+			t.Fatal(err)
+		}
+		if sheet.MaxCol < maxCol {
+			t.Fatalf("%s: MaxCol shrunk from %d to %d",
+				name, maxCol, sheet.MaxCol)
+		}
+	}
+	var buf bytes.Buffer
+	if err = f.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()),
+		int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reCol := regexp.MustCompile(`<col [^>]*>`)
+	reAttr := regexp.MustCompile(`(min|max|width)="([^"]*)"`)
+	for _, zf := range r.File {
+		if !strings.HasPrefix(zf.Name, "xl/worksheets/sheet") {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		widths := make(map[int]string)
+		for _, col := range reCol.FindAllString(string(data), -1) {
+			attr := make(map[string]string)
+			for _, m := range reAttr.FindAllStringSubmatch(col, -1) {
+				attr[m[1]] = m[2]
+			}
+			min, _ := strconv.Atoi(attr["min"])
+			max, _ := strconv.Atoi(attr["max"])
+			for c := min; c <= max; c++ {
+				if _, ok := widths[c]; ok {
+					t.Fatalf("%s: overlapping <col> for %d: %s",
+						zf.Name, c, col)
+				}
+				widths[c] = attr["width"]
+			}
+		}
+		if widths[2] != "30" || widths[1] == "30" {
+			t.Fatalf("%s: got widths %v", zf.Name, widths)
+		}
+	}
+	// a reopened file keeps the width
+	c, err := f.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet, err := c.SheetByName("sort_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := sheet.Cols[1].Width; w != 30 {
+		t.Fatalf("width of B is %v; want 30", w)
+	}
+}
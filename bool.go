@@ -0,0 +1,57 @@
+package xlsxtra
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BoolParser parses booleans formatted as text with a
+// vocabulary of true and false values. Values are compared
+// case insensitive and without surrounding spaces. If Strict
+// is false, unknown values are false, otherwise they give an
+// error.
+type BoolParser struct {
+	True, False []string
+	Strict      bool
+}
+
+// Bools is the bool parser used by Col.Bool and Col.BoolMap.
+// (Use Col.BoolWith and Col.BoolMapWith for another parser,
+// such as a strict copy, instead of changing Bools.) It
+// understands excel TRUE/FALSE cells, english, dutch,
+// german, french and spanish and check marks.
+var Bools = &BoolParser{
+	True: []string{"1", "true", "yes", "y", "ja", "j",
+		"waar", "wahr", "oui", "vrai", "si", "sí", "x", "✓",
+		"✔"},
+	False: []string{"0", "false", "no", "n", "nee",
+		"onwaar", "nein", "falsch", "non", "faux", "-", "✗",
+		"✘", ""},
+}
+
+// Parse a bool.
+func (p *BoolParser) Parse(s string) (bool, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, t := range p.True {
+		if s == strings.ToLower(t) {
+			return true, nil
+		}
+	}
+	if !p.Strict {
+		return false, nil
+	}
+	for _, f := range p.False {
+		if s == strings.ToLower(f) {
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("BoolParser.Parse: unknown bool value %q",
+		s)
+}
+
+// strict returns a strict copy of the parser
+func (p *BoolParser) strict() *BoolParser {
+	c := *p
+	c.Strict = true
+	return &c
+}
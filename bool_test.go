@@ -0,0 +1,81 @@
+package xlsxtra_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleBoolParser_Parse() {
+	strict := &xlsxtra.BoolParser{
+		True:   []string{"on"},
+		False:  []string{"off"},
+		Strict: true,
+	}
+	for _, s := range []string{"ON", " off ", "of"} {
+		fmt.Println(strict.Parse(s))
+	}
+	// Output:
+	// true <nil>
+	// false <nil>
+	// false BoolParser.Parse: unknown bool value "of"
+}
+
+func TestBoolParser(t *testing.T) {
+	for _, s := range []string{"TRUE", "Yes", "ja", "x", "✓", "1"} {
+		got, err := xlsxtra.Bools.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got {
+			t.Fatalf("Bools.Parse(%q): got false; want true", s)
+		}
+	}
+	for _, s := range []string{"FALSE", "nee", "0", "", "typo"} {
+		got, err := xlsxtra.Bools.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got {
+			t.Fatalf("Bools.Parse(%q): got true; want false", s)
+		}
+	}
+	// strict mode without changing Bools
+	strict := *xlsxtra.Bools
+	strict.Strict = true
+	sheet, _ := xlsxtra.NewFile().AddSheet("Sheet1")
+	sheet.AddRow().AddString("a", "b")
+	row := sheet.AddRow()
+	row.AddString("ja", "typo")
+	col := xlsxtra.NewCol(sheet, 1)
+	if b, err := col.BoolWith(&strict, row, "a"); !b || err != nil {
+		t.Fatalf("BoolWith: got %v, %v", b, err)
+	}
+	var perr *xlsxtra.ParseError
+	_, err := col.BoolWith(&strict, row, "b")
+	if !errors.As(err, &perr) || perr.Coord != "B2" {
+		t.Fatalf("BoolWith: got %v", err)
+	}
+	_, err = col.BoolMapWith(&strict, row, []string{"a", "b"})
+	if err == nil {
+		t.Fatal("BoolMapWith: expected error")
+	}
+	bmap, err := col.BoolMap(row, []string{"a", "b"})
+	if err != nil || !bmap["a"] || bmap["b"] {
+		t.Fatalf("BoolMap: got %v, %v", bmap, err)
+	}
+	schema := xlsxtra.Schema{
+		{Header: "bool", Type: xlsxtra.TypeBool},
+	}
+	sheet, _ = xlsxtra.NewFile().AddSheet("Sheet1")
+	sheet.AddRow().AddString("bool")
+	sheet.AddRow().AddString("nee")
+	sheet.AddRow().AddString("jaa")
+	violations := schema.Validate(sheet, 1)
+	if len(violations) != 1 || violations[0].Coord != "A3" {
+		t.Fatalf("Schema: got %v; want violation in A3",
+			violations)
+	}
+}
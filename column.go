@@ -5,12 +5,17 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/tealeg/xlsx"
 )
 
 // Col retrieves values by header label from a row
 type Col map[string]int
 
-// NewCol creates a new Col from a header row
+// NewCol creates a new Col from a header row. Header titles
+// have to match exactly and a later duplicate title replaces
+// an earlier one. (Use NewColOptions for more control.)
 func NewCol(sheet *Sheet, row int) Col {
 	col := make(Col)
 	for i, cell := range sheet.Row(row).Cells {
@@ -28,8 +33,21 @@ func (c Col) Index(title string) (int, error) {
 	if i, ok := c[title]; ok {
 		return i, nil
 	}
-	return 0, fmt.Errorf("Unknown column header: %s (%#v)",
-		title, c)
+	if i, ok := c[normalKey(title)]; ok {
+		return i, nil
+	}
+	return 0, &HeaderError{
+		Header: title, Suggestion: c.suggest(title)}
+}
+
+// index of a given column header title for a row. An
+// unknown title is reported with the sheet of the row.
+func (c Col) index(row *Row, title string) (int, error) {
+	i, err := c.Index(title)
+	if err != nil {
+		return 0, withSheet(err, row.sheetName())
+	}
+	return i, nil
 }
 
 // Indices of given column header titles
@@ -47,42 +65,97 @@ func (c Col) Indices(headers ...string) (
 }
 
 // IndexRow returns index of a given column header title
-// inside a row.
+// inside a row. (A *RangeError is returned if the row is too
+// short. The other methods treat a short row as a row with
+// empty cells.)
 func (c Col) IndexRow(
 	row *Row, title string) (int, error) {
-	i, err := c.Index(title)
+	i, err := c.index(row, title)
 	if err != nil {
 		return 0, err
 	}
 	if i <= len(row.Cells) {
 		return i, nil
 	}
-	return i, fmt.Errorf(
-		`IndexRow: Index %d out of range for column title: %s
-Try to set a value in a column to the far right.
-[%d][%s]`,
-		i, title, len(row.Cells),
-		strings.Join(ToString(row.Cells), "|"))
+	r := row.index()
+	return i, fmt.Errorf("IndexRow: %w", &RangeError{
+		Sheet:  row.sheetName(),
+		Coord:  Coord(i, r),
+		Header: title,
+		Row:    r,
+		Col:    i,
+		MaxRow: r,
+		MaxCol: len(row.Cells),
+	})
+}
+
+// value returns the index and the text of (row,col). A row
+// which is too short has an empty cell.
+func (c Col) value(row *Row, header string) (int, string,
+	error) {
+	i, err := c.index(row, header)
+	if err != nil {
+		return 0, "", err
+	}
+	if i < 1 {
+		return 0, "", &HeaderError{Sheet: row.sheetName(),
+			Header: header}
+	}
+	if i > len(row.Cells) {
+		return i, "", nil
+	}
+	return i, row.Cells[i-1].Value, nil
 }
 
-// Bool value of (row,col) in spreadsheet
+// parseError wraps an error of parsing the value of cell i
+// of a row into a *ParseError.
+func (c Col) parseError(row *Row, header string, i int,
+	value string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ParseError{
+		Sheet:  row.sheetName(),
+		Coord:  Coord(i, row.index()),
+		Header: header,
+		Value:  value,
+		Err:    err,
+	}
+}
+
+// Bool value of (row,col) in spreadsheet, parsed with
+// Bools
 func (c Col) Bool(row *Row, header string) (bool,
 	error) {
-	val, err := c.String(row, header)
+	return c.BoolWith(Bools, row, header)
+}
+
+// BoolWith value of (row,col) in spreadsheet, parsed with p
+func (c Col) BoolWith(p *BoolParser, row *Row,
+	header string) (bool, error) {
+	i, v, err := c.value(row, header)
 	if err != nil {
 		return false, err
 	}
-	val = strings.ToLower(val)
-	return val == "ja" || val == "yes" || val == "1", nil
+	b, err := p.Parse(v)
+	return b, c.parseError(row, header, i, v, err)
 }
 
-// BoolMap value of (row,col) in spreadsheet
+// BoolMap value of (row,col) in spreadsheet, parsed with
+// Bools
 func (c Col) BoolMap(row *Row, headers []string) (
 	map[string]bool, error) {
+	return c.BoolMapWith(Bools, row, headers)
+}
+
+// BoolMapWith value of (row,col) in spreadsheet, parsed with
+// p
+func (c Col) BoolMapWith(p *BoolParser, row *Row,
+	headers []string) (map[string]bool, error) {
 	var err error
 	bmap := make(map[string]bool)
 	for _, header := range headers {
-		bmap[header], err = c.Bool(row, header)
+		bmap[header], err = c.BoolWith(p, row, header)
 		if err != nil {
 			return nil, err
 		}
@@ -90,50 +163,225 @@ func (c Col) BoolMap(row *Row, headers []string) (
 	return bmap, nil
 }
 
-// Int value of (row,col) in spreadsheet
+// Get returns the value of (row,col) in spreadsheet with
+// its natural type: nil for an empty cell (or a row which is
+// too short), a bool, a time.Time or time.Duration for a
+// date or time number format, Money for a currency format,
+// an int for an integral number, a float64 or a string.
+func (c Col) Get(row *Row, header string) (interface{},
+	error) {
+	i, err := c.index(row, header)
+	if err != nil {
+		return nil, err
+	}
+	if i > len(row.Cells) || i < 1 {
+		return nil, nil
+	}
+	cell := row.Cells[i-1]
+	if cell.Value == "" {
+		return nil, nil
+	}
+	switch cell.Type() {
+	case xlsx.CellTypeString, xlsx.CellTypeInline,
+		xlsx.CellTypeError:
+		return cell.Value, nil
+	case xlsx.CellTypeBool:
+		return cell.Bool(), nil
+	}
+	f, err := strconv.ParseFloat(cell.Value, 64)
+	if err != nil {
+		return cell.Value, nil
+	}
+	switch format := cell.NumFmt; {
+	case isDurationFormat(format):
+		return time.Duration(f * nsPerDay), nil
+	case isDateFormat(format):
+		return FromExcelTime(f, row.date1904()), nil
+	case currencyFromFormat(format) != "":
+		return Money{Amount: f,
+			Currency: currencyFromFormat(format)}, nil
+	case f == math.Trunc(f) && math.Abs(f) < 1<<53:
+		return int(f), nil
+	}
+	return f, nil
+}
+
+// Int value of (row,col) in spreadsheet. Text is parsed
+// with Numbers.
 func (c Col) Int(row *Row, header string) (int,
 	error) {
-	i, err := c.IndexRow(row, header)
+	i, v, err := c.value(row, header)
 	if err != nil {
 		return 0, err
 	}
-	return row.Cells[i-1].Int()
+	f, err := number(row, i, v)
+	if err != nil {
+		return -1, c.parseError(row, header, i, v, err)
+	}
+	return int(f), nil
 }
 
-// Float value of (row,col) in spreadsheet
+// Float value of (row,col) in spreadsheet. Text is parsed
+// with Numbers.
 func (c Col) Float(row *Row, header string) (float64,
 	error) {
-	i, err := c.IndexRow(row, header)
+	i, v, err := c.value(row, header)
 	if err != nil {
 		return 0, err
 	}
-	val := row.Cells[i-1].Value
-	if strings.HasPrefix(val, "€") ||
-		strings.HasPrefix(val, "$") {
-		val = strings.TrimLeft(val, "€$ ")
+	f, err := number(row, i, v)
+	return f, c.parseError(row, header, i, v, err)
+}
+
+// number parses the value v of cell i of a row. Numeric and
+// formula cells always use a "." as decimal point, so only
+// text is parsed with Numbers.
+func number(row *Row, i int, v string) (float64, error) {
+	if i <= len(row.Cells) && isNumeric(row.Cells[i-1]) {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f, nil
+		}
 	}
-	f, err := strconv.ParseFloat(val, 64)
+	return Numbers.Parse(v)
+}
+
+// Money value of (row,col) in spreadsheet. The currency
+// is taken from the number format of a numeric cell or
+// detected in text with Numbers.
+func (c Col) Money(row *Row, header string) (Money,
+	error) {
+	i, v, err := c.value(row, header)
 	if err != nil {
-		return math.NaN(), err
+		return Money{}, err
 	}
-	return f, nil
+	if i <= len(row.Cells) && isNumeric(row.Cells[i-1]) {
+		cur := currencyFromFormat(row.Cells[i-1].NumFmt)
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return Money{Amount: f, Currency: cur}, nil
+		}
+	}
+	m, err := Numbers.ParseMoney(v)
+	return m, c.parseError(row, header, i, v, err)
+}
+
+// present checks if the cell of (row,col) has a value. A
+// row which is too short has an empty cell.
+func (c Col) present(row *Row, header string) (bool, error) {
+	_, v, err := c.value(row, header)
+	return strings.TrimSpace(v) != "", err
+}
+
+// NullBool value of (row,col) in spreadsheet. ok is false if
+// the cell is empty or the row is too short.
+func (c Col) NullBool(row *Row, header string) (
+	b bool, ok bool, err error) {
+	ok, err = c.present(row, header)
+	if !ok {
+		return false, false, err
+	}
+	b, err = c.Bool(row, header)
+	return b, true, err
+}
+
+// NullFloat value of (row,col) in spreadsheet. ok is false
+// if the cell is empty or the row is too short.
+func (c Col) NullFloat(row *Row, header string) (
+	f float64, ok bool, err error) {
+	ok, err = c.present(row, header)
+	if !ok {
+		return 0, false, err
+	}
+	f, err = c.Float(row, header)
+	return f, true, err
+}
+
+// NullInt value of (row,col) in spreadsheet. ok is false if
+// the cell is empty or the row is too short.
+func (c Col) NullInt(row *Row, header string) (
+	i int, ok bool, err error) {
+	ok, err = c.present(row, header)
+	if !ok {
+		return 0, false, err
+	}
+	i, err = c.Int(row, header)
+	return i, true, err
+}
+
+// NullString value of (row,col) in spreadsheet. ok is false
+// if the cell is empty or the row is too short.
+func (c Col) NullString(row *Row, header string) (
+	s string, ok bool, err error) {
+	ok, err = c.present(row, header)
+	if !ok {
+		return "", false, err
+	}
+	s, err = c.String(row, header)
+	return s, true, err
 }
 
-// String value of (row,col) in spreadsheet
+// FloatOr returns the float value of (row,col) or def if
+// the cell is empty or the row is too short.
+func (c Col) FloatOr(row *Row, header string,
+	def float64) (float64, error) {
+	f, ok, err := c.NullFloat(row, header)
+	if !ok && err == nil {
+		return def, nil
+	}
+	return f, err
+}
+
+// IntOr returns the int value of (row,col) or def if the
+// cell is empty or the row is too short.
+func (c Col) IntOr(row *Row, header string, def int) (int,
+	error) {
+	i, ok, err := c.NullInt(row, header)
+	if !ok && err == nil {
+		return def, nil
+	}
+	return i, err
+}
+
+// String value of (row,col) in spreadsheet. A row which is
+// too short gives an empty string. (Use NullString to detect
+// an empty cell.)
 func (c Col) String(row *Row, header string) (string,
 	error) {
-	i, err := c.IndexRow(row, header)
-	if err != nil {
+	i, _, err := c.value(row, header)
+	if err != nil || i > len(row.Cells) {
 		return "", err
 	}
-	/** unreachable code fix this for all
-		if i >= len(row.Cells) {
-			return "", nil
-		}
-	**/
 	return row.Cells[i-1].String()
 }
 
+// Time value of (row,col) in spreadsheet. Numeric cells are
+// converted from excel serial numbers (in the date system of
+// the file); text is parsed with ParseTime.
+func (c Col) Time(row *Row, header string) (time.Time,
+	error) {
+	i, v, err := c.value(row, header)
+	if err != nil {
+		return time.Time{}, err
+	}
+	val := strings.TrimSpace(v)
+	if f, err := strconv.ParseFloat(val, 64); err == nil {
+		return FromExcelTime(f, row.date1904()), nil
+	}
+	t, err := ParseTime(val)
+	return t, c.parseError(row, header, i, v, err)
+}
+
+// NullTime value of (row,col) in spreadsheet. ok is false if
+// the cell is empty or the row is too short.
+func (c Col) NullTime(row *Row, header string) (
+	t time.Time, ok bool, err error) {
+	ok, err = c.present(row, header)
+	if !ok {
+		return time.Time{}, false, err
+	}
+	t, err = c.Time(row, header)
+	return t, true, err
+}
+
 // StringFloatMap converts column with days string into
 // a map of floats.
 func (c Col) StringFloatMap(row *Row, header string,
@@ -153,3 +401,90 @@ func (c Col) StringFloatMap(row *Row, header string,
 	}
 	return nil
 }
+
+// cell returns the cell of (row,col) to write into. A row
+// which is too short is extended with empty cells.
+func (c Col) cell(row *Row, header string) (*xlsx.Cell, error) {
+	i, err := c.index(row, header)
+	if err != nil {
+		return nil, err
+	}
+	if i < 1 {
+		return nil, &HeaderError{Sheet: row.sheetName(),
+			Header: header}
+	}
+	if n := i - len(row.Cells); n > 0 {
+		row.AddEmpty(n)
+	}
+	return row.Cells[i-1], nil
+}
+
+// SetBool sets a bool as 1 or 0 in (row,col)
+func (c Col) SetBool(row *Row, header string, x bool) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	if x {
+		cell.SetInt(1)
+	} else {
+		cell.SetInt(0)
+	}
+	return nil
+}
+
+// SetFloat sets a float64 value with format in (row,col)
+func (c Col) SetFloat(row *Row, header, format string,
+	x float64) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	cell.SetFloatWithFormat(x, format)
+	return nil
+}
+
+// SetFormula sets a formula with format in (row,col)
+func (c Col) SetFormula(row *Row, header, format,
+	formula string) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	cell.SetFormula(formula)
+	cell.NumFmt = format
+	return nil
+}
+
+// SetInt sets an int value in (row,col)
+func (c Col) SetInt(row *Row, header string, x int) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	cell.SetInt(x)
+	return nil
+}
+
+// SetString sets a string value in (row,col)
+func (c Col) SetString(row *Row, header, x string) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	cell.SetString(x)
+	return nil
+}
+
+// SetTime sets a date and time in (row,col), formatted with
+// TimeFormat
+func (c Col) SetTime(row *Row, header string,
+	x time.Time) error {
+	cell, err := c.cell(row, header)
+	if err != nil {
+		return err
+	}
+	cell.SetDateTimeWithFormat(
+		ExcelTime(x, row.date1904()), TimeFormat)
+	return nil
+}
@@ -1,8 +1,10 @@
 package xlsxtra_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stanim/xlsxtra"
 	"github.com/tealeg/xlsx"
@@ -249,3 +251,280 @@ func TestCol(t *testing.T) {
 	checkEmpty(t, col, row1)
 	checkErrors(t, data, col, row1, row3)
 }
+
+func TestCol_Time(t *testing.T) {
+	file := xlsxtra.NewFile()
+	sheet, err := file.AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := sheet.AddRow()
+	header.AddString("date", "text", "invalid")
+	want := time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC)
+	row := sheet.AddRow()
+	row.AddDate(want)
+	row.AddString("31-01-2017", "tomorrow")
+	col := xlsxtra.NewCol(sheet, 1)
+	for _, header := range []string{"date", "text"} {
+		got, err := col.Time(row, header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("Col.Time(%q): got %v; want %v",
+				header, got, want)
+		}
+	}
+	_, err = col.Time(row, "invalid")
+	if err == nil {
+		t.Fatal("Col.Time: expected error for invalid date")
+	}
+	_, err = col.Time(row, "not existing")
+	if err == nil {
+		t.Fatal("Col.Time: expected error for not existing")
+	}
+	// 1904 date system
+	file.Date1904 = true
+	row = sheet.AddRow()
+	row.AddDate(want)
+	if row.Cells[0].Value != "41304" {
+		t.Fatalf("AddDate: got serial %s; want 41304",
+			row.Cells[0].Value)
+	}
+	got, err := col.Time(row, "date")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Fatalf("Col.Time(1904): got %v; want %v", got, want)
+	}
+}
+
+func TestCol_Numbers(t *testing.T) {
+	defer func(p *xlsxtra.NumberParser) { xlsxtra.Numbers = p }(
+		xlsxtra.Numbers)
+	xlsxtra.Numbers = xlsxtra.NumbersEU
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("small", "half", "int", "text", "price")
+	row := sheet.AddRow()
+	row.AddFloat("0.000", 1.234, 2.5)
+	row.AddInt(1234)
+	row.AddString("1.234,5")
+	row.AddFloat("#,##0.00", 3.5)
+	col := xlsxtra.NewCol(sheet, 1)
+	for header, want := range map[string]float64{
+		"small": 1.234, "half": 2.5, "int": 1234, "text": 1234.5,
+	} {
+		got, err := col.Float(row, header)
+		if err != nil || got != want {
+			t.Errorf("Float(%q): got %v, %v; want %v",
+				header, got, err, want)
+		}
+	}
+	n, err := col.Int(row, "half")
+	if err != nil || n != 2 {
+		t.Errorf("Int: got %v, %v", n, err)
+	}
+	m, err := col.Money(row, "price")
+	if err != nil || m.Amount != 3.5 {
+		t.Errorf("Money: got %v, %v", m, err)
+	}
+}
+
+func ExampleCol_NullInt() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("a", "b", "c")
+	row := sheet.AddRow()
+	row.AddInt(0)
+	row.AddString(" ")
+	col := xlsxtra.NewCol(sheet, 1)
+	for _, header := range []string{"a", "b", "c"} {
+		fmt.Println(col.NullInt(row, header))
+	}
+	fmt.Println(col.IntOr(row, "c", 42))
+	// Output:
+	// 0 true <nil>
+	// 0 false <nil>
+	// 0 false <nil>
+	// 42 <nil>
+}
+
+func TestCol_Null(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("float", "string", "empty")
+	row := sheet.AddRow()
+	row.AddString("2,5", "text")
+	col := xlsxtra.NewCol(sheet, 1)
+	f, err := col.FloatOr(row, "float", 1)
+	if err != nil || f != 2.5 {
+		t.Fatalf("FloatOr: got %v, %v; want 2.5", f, err)
+	}
+	f, err = col.FloatOr(row, "empty", 1)
+	if err != nil || f != 1 {
+		t.Fatalf("FloatOr: got %v, %v; want 1", f, err)
+	}
+	_, err = col.FloatOr(row, "string", 1)
+	if !errors.Is(err, xlsxtra.ErrParse) {
+		t.Fatalf("FloatOr: got %v; want parse error", err)
+	}
+	_, _, err = col.NullFloat(row, "not existing")
+	if !errors.Is(err, xlsxtra.ErrUnknownHeader) {
+		t.Fatalf("NullFloat: got %v; want unknown header", err)
+	}
+	s, ok, err := col.NullString(row, "string")
+	if s != "text" || !ok || err != nil {
+		t.Fatalf("NullString: got %q, %v, %v", s, ok, err)
+	}
+	_, ok, err = col.NullString(row, "empty")
+	if ok || err != nil {
+		t.Fatalf("NullString: got %v, %v; want false", ok, err)
+	}
+	i, err := col.IntOr(row, "string", 1)
+	if err == nil {
+		t.Fatalf("IntOr: got %d; want error", i)
+	}
+	// short rows are empty
+	s, err = col.String(row, "empty")
+	if s != "" || err != nil {
+		t.Fatalf("String: got %q, %v; want empty", s, err)
+	}
+	_, err = col.Float(row, "empty")
+	var perr *xlsxtra.ParseError
+	if !errors.As(err, &perr) || perr.Coord != "C2" {
+		t.Fatalf("Float: got %v; want parse error of C2", err)
+	}
+	_, ok, err = col.NullBool(row, "empty")
+	if ok || err != nil {
+		t.Fatalf("NullBool: got %v, %v; want false", ok, err)
+	}
+	_, ok, err = col.NullTime(row, "empty")
+	if ok || err != nil {
+		t.Fatalf("NullTime: got %v, %v; want false", ok, err)
+	}
+	row.AddString("yes")
+	b, ok, err := col.NullBool(row, "empty")
+	if !b || !ok || err != nil {
+		t.Fatalf("NullBool: got %v, %v, %v", b, ok, err)
+	}
+	row.Cells[2].SetString("2017-01-31")
+	tm, ok, err := col.NullTime(row, "empty")
+	if tm.Day() != 31 || !ok || err != nil {
+		t.Fatalf("NullTime: got %v, %v, %v", tm, ok, err)
+	}
+	_, err = col.String(row, "-float")
+	if !errors.Is(err, xlsxtra.ErrUnknownHeader) {
+		t.Fatalf("String: got %v; want unknown header", err)
+	}
+}
+
+func ExampleCol_SetString() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Orders")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("id", "amount", "status")
+	row := sheet.AddRow()
+	row.AddInt(1)
+	col := xlsxtra.NewCol(sheet, 1)
+	err = col.SetString(row, "status", "shipped")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%q\n", xlsxtra.ToString(row.Cells))
+	// Output: ["1" "" "shipped"]
+}
+
+func TestCol_Set(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString(
+		"bool", "float", "formula", "int", "time")
+	row := sheet.AddRow()
+	col := xlsxtra.NewCol(sheet, 1)
+	want := time.Date(2017, 1, 31, 12, 0, 0, 0, time.UTC)
+	for _, err := range []error{
+		col.SetTime(row, "time", want),
+		col.SetBool(row, "bool", true),
+		col.SetFloat(row, "float", "0.00", 2.5),
+		col.SetFormula(row, "formula", "0.00", "B2*2"),
+		col.SetInt(row, "int", 7),
+		col.SetBool(row, "bool", false),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := fmt.Sprint(xlsxtra.ToString(row.Cells))
+	if got != "[0 2.5  7 42766.5]" {
+		t.Fatalf("got %s", got)
+	}
+	if f := row.Cells[2].Formula(); f != "B2*2" {
+		t.Fatalf("SetFormula: got %q; want \"B2*2\"", f)
+	}
+	tm, err := col.Time(row, "time")
+	if err != nil || !tm.Equal(want) {
+		t.Fatalf("SetTime: got %v, %v; want %v", tm, err, want)
+	}
+	err = col.SetInt(row, "not existing", 1)
+	if !errors.Is(err, xlsxtra.ErrUnknownHeader) {
+		t.Fatalf("got %v; want unknown header", err)
+	}
+	err = col.SetInt(row, "-int", 1)
+	if !errors.Is(err, xlsxtra.ErrUnknownHeader) {
+		t.Fatalf("got %v; want unknown header for reverse", err)
+	}
+}
+
+func TestCol_Get(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Date(2017, 1, 31, 12, 0, 0, 0, time.UTC)
+	money := xlsxtra.Money{Amount: 9.5, Currency: "EUR"}
+	values := []interface{}{"text", 42, 2.5, true, date,
+		90 * time.Minute, money, nil}
+	header := sheet.AddRow()
+	for i := range values {
+		header.AddString(fmt.Sprint(i))
+	}
+	sheet.AddRow().Add(values...)
+	col := xlsxtra.NewCol(sheet, 1)
+	for i, want := range append(values, nil) {
+		if want == true {
+			want = 1 // AddBool writes 1 or 0
+		}
+		got, err := col.Get(sheet.Row(2), fmt.Sprint(i))
+		if i == len(values) {
+			if err == nil {
+				t.Fatal("Get: expected error for unknown header")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("Get: got %#v; want %#v", got, want)
+		}
+	}
+	sheet.Row(2).Cells[3].SetBool(true)
+	got, err := col.Get(sheet.Row(2), "3")
+	if err != nil || got != true {
+		t.Fatalf("Get: got %#v, %v; want true", got, err)
+	}
+}
@@ -0,0 +1,134 @@
+package xlsxtra
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors to check with errors.Is for the kind of failure
+var (
+	ErrUnknownHeader = errors.New("unknown column header")
+	ErrOutOfRange    = errors.New("out of range")
+	ErrInvalidCoord  = errors.New("invalid coordinate")
+	ErrParse         = errors.New("parse failure")
+)
+
+// HeaderError reports an unknown column header title. (Use
+// errors.As to retrieve it.) Sheet is empty if the error does
+// not involve a row, such as for Col.Index.
+type HeaderError struct {
+	Sheet      string
+	Header     string
+	Suggestion string // closest known header title
+}
+
+func (e *HeaderError) Error() string {
+	msg := fmt.Sprintf("Unknown column header: %s", e.Header)
+	if e.Sheet != "" {
+		msg += fmt.Sprintf(" of sheet %q", e.Sheet)
+	}
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", e.Suggestion)
+	}
+	return msg
+}
+
+// Is makes errors.Is(err, ErrUnknownHeader) true
+func (e *HeaderError) Is(target error) bool {
+	return target == ErrUnknownHeader
+}
+
+// RangeError reports a cell outside of a sheet or row. Row
+// and Col are one based. If Row is larger than MaxRow the
+// row is out of range, otherwise the column.
+type RangeError struct {
+	Sheet          string
+	Coord          string
+	Header         string
+	Row, Col       int
+	MaxRow, MaxCol int
+}
+
+func (e *RangeError) Error() string {
+	where := e.Coord
+	if e.Header != "" {
+		where = fmt.Sprintf("%s (%s)", e.Coord, e.Header)
+	}
+	if e.Row > e.MaxRow {
+		return fmt.Sprintf(
+			"row %d of %s out of range of sheet %q (max %d)",
+			e.Row, where, e.Sheet, e.MaxRow)
+	}
+	return fmt.Sprintf(
+		"column %d of %s out of range of sheet %q (max %d)",
+		e.Col, where, e.Sheet, e.MaxCol)
+}
+
+// Is makes errors.Is(err, ErrOutOfRange) true
+func (e *RangeError) Is(target error) bool {
+	return target == ErrOutOfRange
+}
+
+// CoordError reports an invalid cell coordinate or range.
+// Sheet is empty if the error does not involve a sheet, such
+// as for RangeBounds.
+type CoordError struct {
+	Sheet string
+	Ref   string
+	Range bool // a range was expected
+}
+
+func (e *CoordError) Error() string {
+	msg := fmt.Sprintf("Invalid cell coordinates %q", e.Ref)
+	if e.Range {
+		msg = fmt.Sprintf("Invalid range %q", e.Ref)
+	}
+	if e.Sheet != "" {
+		msg += fmt.Sprintf(" of sheet %q", e.Sheet)
+	}
+	return msg
+}
+
+// Is makes errors.Is(err, ErrInvalidCoord) true
+func (e *CoordError) Is(target error) bool {
+	return target == ErrInvalidCoord
+}
+
+// ParseError reports a cell value which could not be parsed.
+// Err is the underlying error.
+type ParseError struct {
+	Sheet  string
+	Coord  string
+	Header string
+	Value  string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s (%s) of sheet %q: %v",
+		e.Coord, e.Header, e.Sheet, e.Err)
+}
+
+// Is makes errors.Is(err, ErrParse) true
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// withSheet sets the sheet of a *HeaderError or *CoordError
+// in the chain of err, unless it has one already.
+func withSheet(err error, sheet string) error {
+	var herr *HeaderError
+	if errors.As(err, &herr) && herr.Sheet == "" {
+		herr.Sheet = sheet
+	}
+	var cerr *CoordError
+	if errors.As(err, &cerr) && cerr.Sheet == "" {
+		cerr.Sheet = sheet
+	}
+	return err
+}
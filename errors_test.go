@@ -0,0 +1,126 @@
+package xlsxtra_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleParseError() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Orders")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("id", "price")
+	row := sheet.AddRow()
+	row.AddString("1", "free")
+	col := xlsxtra.NewCol(sheet, 1)
+	_, err = col.Float(row, "price")
+	var perr *xlsxtra.ParseError
+	if errors.As(err, &perr) {
+		fmt.Println(perr.Sheet, perr.Coord, perr.Header, perr.Value)
+	}
+	fmt.Println(err)
+	// Output:
+	// Orders B2 price free
+	// B2 (price) of sheet "Orders": strconv.ParseFloat: parsing "free": invalid syntax
+}
+
+func TestErrors(t *testing.T) {
+	sheet := newSheetUtils()
+	sheet.AddRow().AddString("A3")
+	col := xlsxtra.NewCol(sheet, 1)
+	tests := []struct {
+		err    error
+		target error
+	}{
+		{func() error { _, err := col.Index("C1"); return err }(),
+			xlsxtra.ErrUnknownHeader},
+		{func() error {
+			_, err := col.IndexRow(sheet.Row(3), "B1")
+			return err
+		}(), xlsxtra.ErrOutOfRange},
+		{func() error { _, err := sheet.Cell("B3"); return err }(),
+			xlsxtra.ErrOutOfRange},
+		{func() error { _, err := sheet.Cell("A4"); return err }(),
+			xlsxtra.ErrOutOfRange},
+		{func() error { _, err := sheet.Cell("ZZZZ1"); return err }(),
+			xlsxtra.ErrInvalidCoord},
+		{func() error { _, err := sheet.Cell("1A"); return err }(),
+			xlsxtra.ErrInvalidCoord},
+		{func() error {
+			_, err := sheet.CellRange("A1:B3")
+			return err
+		}(), xlsxtra.ErrOutOfRange},
+		{func() error {
+			_, _, _, _, err := xlsxtra.RangeBounds("A0:B3")
+			return err
+		}(), xlsxtra.ErrInvalidCoord},
+		{func() error {
+			_, err := col.Int(sheet.Row(2), "A1")
+			return err
+		}(), xlsxtra.ErrParse},
+	}
+	for i, test := range tests {
+		if !errors.Is(test.err, test.target) {
+			t.Fatalf("%d: got %v; want %v", i, test.err, test.target)
+		}
+	}
+	_, err := col.IndexRow(sheet.Row(3), "B1")
+	var rerr *xlsxtra.RangeError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("got %T; want *RangeError", err)
+	}
+	if rerr.Coord != "B3" || rerr.Header != "B1" ||
+		rerr.Sheet != "Sheet" {
+		t.Fatalf("got %#v", rerr)
+	}
+	want := `IndexRow: column 2 of B3 (B1) out of range of sheet "Sheet" (max 1)`
+	if err.Error() != want {
+		t.Fatalf("got %q; want %q", err, want)
+	}
+}
+
+func TestErrors_Sheet(t *testing.T) {
+	sheet := newSheetUtils()
+	col := xlsxtra.NewCol(sheet, 1)
+	_, err := col.Float(sheet.Row(2), "price")
+	var herr *xlsxtra.HeaderError
+	if !errors.As(err, &herr) || herr.Sheet != "Sheet" {
+		t.Fatalf("got %v; want *HeaderError of sheet", err)
+	}
+	want := `Unknown column header: price of sheet "Sheet"`
+	if err.Error() != want {
+		t.Fatalf("got %q; want %q", err, want)
+	}
+	_, err = col.Index("price")
+	if !errors.As(err, &herr) || herr.Sheet != "" {
+		t.Fatalf("got %v; want *HeaderError without sheet", err)
+	}
+	for _, err = range []error{
+		func() error { _, err := sheet.Cell("1A"); return err }(),
+		func() error {
+			_, err := sheet.CellRange("A1:B")
+			return err
+		}(),
+		sheet.Merge("B2:A1"),
+		sheet.SetColWidth("1", 10),
+		sheet.FreezePanes("A"),
+	} {
+		var cerr *xlsxtra.CoordError
+		if !errors.As(err, &cerr) || cerr.Sheet != "Sheet" {
+			t.Errorf("got %v; want *CoordError of sheet", err)
+		}
+	}
+	// the row index is found after reordering rows
+	row := sheet.Row(2)
+	sheet.Rows[0], sheet.Rows[1] = sheet.Rows[1], sheet.Rows[0]
+	_, err = col.Int(row, "A1")
+	var perr *xlsxtra.ParseError
+	if !errors.As(err, &perr) || perr.Coord != "A1" {
+		t.Fatalf("got %v; want *ParseError of A1", err)
+	}
+}
@@ -1,6 +1,7 @@
 package xlsxtra
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/tealeg/xlsx"
@@ -26,6 +27,21 @@ func OpenFile(fn string) (*File, error) {
 	return &File{File: f, filename: fn}, nil
 }
 
+// Copy returns a deep copy of the spreadsheet file, for
+// example to mark errors without altering the original.
+func (f *File) Copy() (*File, error) {
+	var buf bytes.Buffer
+	err := f.Write(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("Copy: %v", err)
+	}
+	c, err := xlsx.OpenBinary(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Copy: %v", err)
+	}
+	return &File{File: c, filename: f.filename}, nil
+}
+
 // AddSheet with certain name to spreadsheet file
 func (f *File) AddSheet(name string) (*Sheet, error) {
 	sheet, err := f.File.AddSheet(name)
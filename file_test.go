@@ -63,3 +63,25 @@ func TestFile_SheetMap(t *testing.T) {
 		}
 	}
 }
+
+func TestFile_Copy(t *testing.T) {
+	f := newFile(t)
+	c, err := f.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Sheets) != len(sheetNames) {
+		t.Fatalf("got %d sheets; want %d",
+			len(c.Sheets), len(sheetNames))
+	}
+	sheet, err := c.AddSheet("copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sheet.File == f.File {
+		t.Fatal("Copy: expected a new file")
+	}
+	if len(f.Sheets) != len(sheetNames) {
+		t.Fatal("Copy: original file was altered")
+	}
+}
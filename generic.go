@@ -0,0 +1,64 @@
+//go:build go1.18
+// +build go1.18
+
+package xlsxtra
+
+import (
+	"fmt"
+	"time"
+)
+
+// Get returns the value of (row,col) in spreadsheet as type
+// T. Strings, ints, float64s, bools, times and Money use the
+// Col accessor of that type, other types the value of
+// Col.Get. An empty cell (or a row which is too short) gives
+// the zero value of T.
+func Get[T any](col Col, row *Row, header string) (T, error) {
+	var zero T
+	ok, err := col.present(row, header)
+	if !ok || err != nil {
+		return zero, err
+	}
+	var v interface{}
+	switch any(zero).(type) {
+	case string:
+		v, err = col.String(row, header)
+	case int:
+		v, err = col.Int(row, header)
+	case float64:
+		v, err = col.Float(row, header)
+	case bool:
+		v, err = col.Bool(row, header)
+	case time.Time:
+		v, err = col.Time(row, header)
+	case Money:
+		v, err = col.Money(row, header)
+	default:
+		v, err = col.Get(row, header)
+	}
+	if err != nil {
+		return zero, err
+	}
+	t, ok := v.(T)
+	if !ok {
+		return zero, fmt.Errorf("Get: %s is %T, not %T",
+			header, v, zero)
+	}
+	return t, nil
+}
+
+// Column returns the values of a column as type T for the
+// rows start to end (see Sheet.RowRange).
+func Column[T any](sheet *Sheet, col Col, header string,
+	start, end int) ([]T, error) {
+	rows := sheet.RowRange(start, end)
+	values := make([]T, len(rows))
+	for i, row := range rows {
+		v, err := Get[T](col, row, header)
+		if err != nil {
+			return nil, fmt.Errorf("Column: %w", err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
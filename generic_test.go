@@ -0,0 +1,87 @@
+//go:build go1.18
+// +build go1.18
+
+package xlsxtra_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleColumn() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Basket")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("item", "price")
+	sheet.AddRow().Add("chocolate", 4.99)
+	sheet.AddRow().Add("cookies", 6.45)
+	col := xlsxtra.NewCol(sheet, 1)
+	prices, err := xlsxtra.Column[float64](sheet, col, "price", 2, -1)
+	fmt.Println(prices, err)
+	items, err := xlsxtra.Column[string](sheet, col, "item", 2, -1)
+	fmt.Println(items, err)
+	// Output:
+	// [4.99 6.45] <nil>
+	// [chocolate cookies] <nil>
+}
+
+func TestGet(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("int", "duration", "text", "empty")
+	row := sheet.AddRow()
+	row.Add(7, 90*time.Minute, "x", nil)
+	col := xlsxtra.NewCol(sheet, 1)
+	i, err := xlsxtra.Get[int](col, row, "int")
+	if err != nil || i != 7 {
+		t.Fatalf("Get[int]: got %v, %v; want 7", i, err)
+	}
+	d, err := xlsxtra.Get[time.Duration](col, row, "duration")
+	if err != nil || d != 90*time.Minute {
+		t.Fatalf("Get[time.Duration]: got %v, %v", d, err)
+	}
+	d, err = xlsxtra.Get[time.Duration](col, row, "empty")
+	if err != nil || d != 0 {
+		t.Fatalf("Get[time.Duration]: got %v, %v; want 0", d, err)
+	}
+	_, err = xlsxtra.Get[time.Duration](col, row, "text")
+	if err == nil {
+		t.Fatal("Get[time.Duration]: expected error for text")
+	}
+	_, err = xlsxtra.Column[int](sheet, col, "text", 2, -1)
+	if !errors.Is(err, xlsxtra.ErrParse) {
+		t.Fatalf("Column[int]: got %v; want parse error", err)
+	}
+}
+
+func TestColumn_Empty(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Basket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("item", "price", "date")
+	sheet.AddRow().Add("chocolate", 4.99, time.Now())
+	sheet.AddRow().Add("cookies", nil, nil)
+	sheet.AddRow().Add("free sample")
+	col := xlsxtra.NewCol(sheet, 1)
+	prices, err := xlsxtra.Column[float64](sheet, col, "price", 2, -1)
+	if err != nil || fmt.Sprint(prices) != "[4.99 0 0]" {
+		t.Fatalf("Column: got %v, %v", prices, err)
+	}
+	ints, err := xlsxtra.Column[int](sheet, col, "price", 3, -1)
+	if err != nil || fmt.Sprint(ints) != "[0 0]" {
+		t.Fatalf("Column: got %v, %v", ints, err)
+	}
+	dates, err := xlsxtra.Column[time.Time](sheet, col, "date", 2, -1)
+	if err != nil || dates[0].IsZero() || !dates[2].IsZero() {
+		t.Fatalf("Column: got %v, %v", dates, err)
+	}
+}
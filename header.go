@@ -0,0 +1,272 @@
+package xlsxtra
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ColOptions configure how NewColOptions maps header titles.
+type ColOptions struct {
+	// Normalize matches header titles case, whitespace and
+	// diacritic insensitive (see NormalizeHeader).
+	Normalize bool
+	// Aliases maps a logical field to alternative header
+	// titles, for example "Qty": {"Amount", "Aantal"}.
+	Aliases map[string][]string
+	// IndexDuplicates gives access to duplicate header titles
+	// by their number, for example "Amount#2". Otherwise
+	// duplicates are an error.
+	IndexDuplicates bool
+}
+
+var diacritics = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"ç", "c", "è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ý", "y", "ÿ", "y",
+	"ß", "ss",
+)
+
+// NormalizeHeader converts a header title to lower case,
+// removes diacritics and surrounding whitespace and
+// collapses inner whitespace into a single space.
+func NormalizeHeader(title string) string {
+	title = diacritics.Replace(strings.ToLower(title))
+	return strings.Join(strings.Fields(title), " ")
+}
+
+// NewColOptions creates a new Col from a header row with
+// options. All duplicate header titles are reported in the
+// error, but the returned Col is still usable.
+func NewColOptions(sheet *Sheet, row int, opts ColOptions) (
+	Col, error) {
+	col := make(Col)
+	count := make(map[string]int)
+	var duplicates []string
+	for i, cell := range sheet.Row(row).Cells {
+		title, _ := cell.String()
+		if strings.TrimSpace(title) == "" {
+			continue
+		}
+		key := title
+		if opts.Normalize {
+			key = NormalizeHeader(title)
+		}
+		count[key]++
+		if n := count[key]; n > 1 {
+			if !opts.IndexDuplicates {
+				duplicates = append(duplicates, title)
+				continue
+			}
+			title = fmt.Sprintf("%s#%d", title, n)
+		}
+		col.add(title, i+1, opts.Normalize)
+	}
+	fields := make([]string, 0, len(opts.Aliases))
+	for field := range opts.Aliases {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		index := 0
+		for _, title := range append([]string{field},
+			opts.Aliases[field]...) {
+			i, err := col.Index(title)
+			if err != nil || i == index {
+				continue
+			}
+			if index != 0 {
+				duplicates = append(duplicates, field)
+				break
+			}
+			index = i
+		}
+		if index != 0 {
+			col.add(field, index, opts.Normalize)
+		}
+	}
+	if len(duplicates) > 0 {
+		return col, fmt.Errorf(
+			"NewColOptions: duplicate column headers: %s",
+			strings.Join(duplicates, ", "))
+	}
+	return col, nil
+}
+
+// NewColRange creates a new Col from the header rows start
+// to end (one based, inclusive). Merged cells are resolved
+// and the titles of a column are joined with "/" into a
+// composite title, such as "2024/Q1". A title which spans
+// several header rows is used only once.
+func NewColRange(sheet *Sheet, start, end int) Col {
+	grid := make([][]string, end-start+1)
+	for r := range grid {
+		for _, cell := range sheet.Row(start + r).Cells {
+			title, _ := cell.String()
+			grid[r] = append(grid[r], strings.TrimSpace(title))
+		}
+	}
+	// copy the title of a merged cell into the cells it covers
+	for r := range grid {
+		for c, cell := range sheet.Row(start + r).Cells {
+			for v := 0; v <= cell.VMerge && r+v < len(grid); v++ {
+				for h := 0; h <= cell.HMerge; h++ {
+					for len(grid[r+v]) <= c+h {
+						grid[r+v] = append(grid[r+v], "")
+					}
+					grid[r+v][c+h] = grid[r][c]
+				}
+			}
+		}
+	}
+	col := make(Col)
+	for c := 0; ; c++ {
+		var parts []string
+		more := false
+		for _, titles := range grid {
+			if c >= len(titles) {
+				continue
+			}
+			more = true
+			title := titles[c]
+			if title != "" && (len(parts) == 0 ||
+				parts[len(parts)-1] != title) {
+				parts = append(parts, title)
+			}
+		}
+		if !more {
+			break
+		}
+		if len(parts) > 0 {
+			col.add(strings.Join(parts, "/"), c+1, false)
+		}
+	}
+	return col
+}
+
+// FindHeader scans the first n rows of a sheet (all rows if
+// n <= 0) for the header row and returns its one based index
+// with its Col. With headers, it is the row which contains
+// most of them (compared with NormalizeHeader). Without
+// headers, it is the row with most distinct non-numeric
+// titles. In case of a tie, the first row wins. The Col
+// normalizes header titles (see NewColOptions), so the
+// headers can be used to retrieve values. Duplicate titles
+// are an error, but the Col is returned as well.
+func FindHeader(sheet *Sheet, n int, headers ...string) (
+	int, Col, error) {
+	if n <= 0 || n > len(sheet.Rows) {
+		n = len(sheet.Rows)
+	}
+	want := make(map[string]bool)
+	for _, header := range headers {
+		want[NormalizeHeader(header)] = true
+	}
+	best, top := 0, 0
+	for r := 1; r <= n; r++ {
+		score := 0
+		seen := make(map[string]bool)
+		for _, cell := range sheet.Row(r).Cells {
+			title, _ := cell.String()
+			title = NormalizeHeader(title)
+			if title == "" || seen[title] {
+				continue
+			}
+			seen[title] = true
+			if len(headers) > 0 {
+				if want[title] {
+					score++
+				}
+			} else if _, err := Numbers.Parse(title); err != nil {
+				score++
+			}
+		}
+		if score > top {
+			best, top = r, score
+		}
+	}
+	if best == 0 || len(headers) == 0 && top < 2 {
+		return 0, nil, fmt.Errorf(
+			"FindHeader: no header row found in first %d rows", n)
+	}
+	col, err := NewColOptions(sheet, best,
+		ColOptions{Normalize: true})
+	if err != nil {
+		return best, col, fmt.Errorf("FindHeader: %v", err)
+	}
+	return best, col, nil
+}
+
+// add a header title with its (reverse) index
+func (c Col) add(title string, i int, normalize bool) {
+	c[title] = i
+	c["-"+title] = -i
+	if normalize {
+		c[normalKey(title)] = i
+		c[normalKey("-"+title)] = -i
+	}
+}
+
+// normalKey is the key of a normalized header title. It is
+// prefixed to be distinct from exact header titles.
+func normalKey(title string) string {
+	return "\x00" + NormalizeHeader(title)
+}
+
+// suggest returns the header title closest to an unknown
+// title or an empty string if none is close enough.
+func (c Col) suggest(title string) string {
+	titles := make([]string, 0, len(c))
+	for t := range c {
+		if !strings.HasPrefix(t, "-") &&
+			!strings.HasPrefix(t, "\x00") {
+			titles = append(titles, t)
+		}
+	}
+	sort.Strings(titles)
+	best, dist := "", utf8.RuneCountInString(title)/3+2
+	lower := strings.ToLower(title)
+	for _, t := range titles {
+		d := levenshtein(lower, strings.ToLower(t))
+		if d < dist {
+			best, dist = t, d
+		}
+	}
+	return best
+}
+
+// levenshtein distance between two strings
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1,
+				prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(t)]
+}
+
+func minInt(x ...int) int {
+	m := x[0]
+	for _, y := range x[1:] {
+		if y < m {
+			m = y
+		}
+	}
+	return m
+}
@@ -0,0 +1,157 @@
+package xlsxtra_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func newHeaderSheet(titles ...string) *xlsxtra.Sheet {
+	sheet, _ := xlsxtra.NewFile().AddSheet("Sheet1")
+	sheet.AddRow().AddString(titles...)
+	sheet.AddRow().AddInt(1, 2, 3, 4)
+	return sheet
+}
+
+func ExampleNewColOptions() {
+	sheet := newHeaderSheet("Naïve  Price ", "Aantal", "Note",
+		"NOTE")
+	col, err := xlsxtra.NewColOptions(sheet, 1,
+		xlsxtra.ColOptions{
+			Normalize:       true,
+			Aliases:         map[string][]string{"Qty": {"Amount", "aantal"}},
+			IndexDuplicates: true,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	row := sheet.Row(2)
+	for _, header := range []string{
+		"naive price", "Qty", "note", "note#2"} {
+		fmt.Println(col.Int(row, header))
+	}
+	fmt.Println(col.Index("-qty"))
+	// Output:
+	// 1 <nil>
+	// 2 <nil>
+	// 3 <nil>
+	// 4 <nil>
+	// -2 <nil>
+}
+
+func TestNewColOptions(t *testing.T) {
+	sheet := newHeaderSheet("price", "amount", "price", "qty")
+	col, err := xlsxtra.NewColOptions(sheet, 1,
+		xlsxtra.ColOptions{
+			Aliases: map[string][]string{"qty": {"amount"}},
+		})
+	if err == nil {
+		t.Fatal("NewColOptions: expected duplicate error")
+	}
+	want := "NewColOptions: duplicate column headers: price, qty"
+	if err.Error() != want {
+		t.Fatalf("got %q; want %q", err, want)
+	}
+	if i, _ := col.Index("price"); i != 1 {
+		t.Fatalf("got index %d; want first price column 1", i)
+	}
+	_, err = col.Index("Price")
+	if err == nil {
+		t.Fatal("Index: expected exact match without Normalize")
+	}
+	want = `Unknown column header: Price (did you mean "price"?)`
+	if err.Error() != want {
+		t.Fatalf("got %q; want %q", err, want)
+	}
+	_, err = col.Index("something else")
+	want = "Unknown column header: something else"
+	if err == nil || err.Error() != want {
+		t.Fatalf("got %v; want %q", err, want)
+	}
+}
+
+func ExampleNewColRange() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Report")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	row := sheet.AddRow()
+	row.AddString("Name").Merge(0, 1)
+	row.AddString("2024").Merge(1, 0)
+	row.AddEmpty(1)
+	row.AddString("Total").Merge(0, 1)
+	sheet.AddRow().AddString("", "Q1", "Q2")
+	for _, name := range []string{"b", "a"} {
+		row = sheet.AddRow()
+		row.AddString(name)
+		row.AddInt(1, 2, 3)
+	}
+	col := xlsxtra.NewColRange(sheet, 1, 2)
+	fmt.Println(col.Indices("Name", "2024/Q1", "2024/Q2", "Total"))
+	err = xlsxtra.SortByHeaders(sheet, 2, -1, col, "Name")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(col.String(sheet.Row(3), "Name"))
+	// Output:
+	// [1 2 3 4] <nil>
+	// a <nil>
+}
+
+func ExampleFindHeader() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Upload")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("Monthly report")
+	sheet.AddRow()
+	sheet.AddRow().AddString("id", "name", "price")
+	row := sheet.AddRow()
+	row.AddInt(1)
+	row.AddString("cookies")
+	row.AddFloat("0.00", 6.45)
+	index, col, err := xlsxtra.FindHeader(sheet, 10, "Name", "Price")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(index)
+	fmt.Println(col.Float(row, "price"))
+	index, _, err = xlsxtra.FindHeader(sheet, 0)
+	fmt.Println(index, err)
+	// Output:
+	// 3
+	// 6.45 <nil>
+	// 3 <nil>
+}
+
+func TestFindHeader(t *testing.T) {
+	sheet := newHeaderSheet("a")
+	_, _, err := xlsxtra.FindHeader(sheet, 2, "b")
+	if err == nil {
+		t.Fatal("FindHeader: expected error for missing header")
+	}
+	_, _, err = xlsxtra.FindHeader(sheet, 0)
+	if err == nil {
+		t.Fatal("FindHeader: expected error for single title")
+	}
+	sheet = newHeaderSheet("ID", "PRICE ", "Qty")
+	index, col, err := xlsxtra.FindHeader(sheet, 2, "Price", "qty")
+	if err != nil || index != 1 {
+		t.Fatalf("FindHeader: got %d, %v", index, err)
+	}
+	price, err := col.Float(sheet.Row(2), "Price")
+	if err != nil || price != 2 {
+		t.Fatalf("Float: got %v, %v", price, err)
+	}
+	sheet = newHeaderSheet("id", "price", "Price")
+	index, col, err = xlsxtra.FindHeader(sheet, 2, "price")
+	if err == nil || index != 1 || col == nil {
+		t.Fatalf("FindHeader: expected duplicate error, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package xlsxtra
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	reCurrencyFmt = regexp.MustCompile(
+		`\[\$([^\]-]+)(-[0-9A-Fa-f]+)?\]`)
+	reFmtQuoted = regexp.MustCompile(`"([^"]*)"|\\(.)`)
+)
+
+// CurrencySymbols maps ISO 4217 currency codes to their
+// symbol.
+var CurrencySymbols = map[string]string{
+	"EUR": "€",
+	"USD": "$",
+	"GBP": "£",
+	"JPY": "¥",
+	"INR": "₹",
+	"KRW": "₩",
+	"RUB": "₽",
+	"TRY": "₺",
+	"ILS": "₪",
+	"NGN": "₦",
+	"PHP": "₱",
+	"VND": "₫",
+	"UAH": "₴",
+	"THB": "฿",
+}
+
+// Money is an amount in a currency. The currency is an ISO
+// 4217 code if it is known, otherwise the symbol as found.
+// An empty currency means none was found.
+type Money struct {
+	Amount   float64
+	Currency string
+}
+
+// String formats money with two decimals
+func (m Money) String() string {
+	if m.Currency == "" {
+		return fmt.Sprintf("%.2f", m.Amount)
+	}
+	return fmt.Sprintf("%s %.2f", m.Currency, m.Amount)
+}
+
+// ParseMoney parses a number and detects its currency.
+func (p *NumberParser) ParseMoney(s string) (Money, error) {
+	f, cur, ok := p.parse(s)
+	if !ok {
+		_, err := p.Parse(s)
+		return Money{Amount: math.NaN()}, err
+	}
+	return Money{Amount: f, Currency: currencyCode(cur)}, nil
+}
+
+// MoneyFormat returns the number format for a currency
+// code, which is used by Row.AddMoney.
+func MoneyFormat(currency string) string {
+	sym, ok := CurrencySymbols[currency]
+	if !ok {
+		sym = currency
+	}
+	return fmt.Sprintf("[$%s] #,##0.00", sym)
+}
+
+// currencyFromFormat returns the currency code of a number
+// format or an empty string if it has no currency. The
+// currency is taken from a locale token ("[$€-407]"), a quoted
+// or escaped literal ("\"$\"#,##0", "\\$#,##0") or a currency
+// symbol.
+func currencyFromFormat(format string) string {
+	if m := reCurrencyFmt.FindStringSubmatch(format); m != nil {
+		return currencyCode(strings.TrimSpace(m[1]))
+	}
+	for _, m := range reFmtQuoted.FindAllStringSubmatch(format, -1) {
+		lit := strings.TrimSpace(m[1] + m[2])
+		if _, ok := CurrencySymbols[strings.ToUpper(lit)]; ok {
+			return strings.ToUpper(lit)
+		}
+		if code := currencyCode(lit); code != lit {
+			return code
+		}
+	}
+	for _, r := range reFmtLiteral.ReplaceAllString(format, "") {
+		if unicode.Is(unicode.Sc, r) {
+			return currencyCode(string(r))
+		}
+	}
+	return ""
+}
+
+// currencyCode converts a currency symbol to an ISO 4217
+// code. ("$" is considered to be "USD".)
+func currencyCode(cur string) string {
+	if cur == "$" {
+		return "USD"
+	}
+	if _, ok := CurrencySymbols[strings.ToUpper(cur)]; ok {
+		// the xlsx package reads number formats in lower case
+		return strings.ToUpper(cur)
+	}
+	for code, sym := range CurrencySymbols {
+		if sym == cur {
+			return code
+		}
+	}
+	return cur
+}
@@ -0,0 +1,90 @@
+package xlsxtra_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleCol_Money() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Invoices")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("total", "text")
+	row := sheet.AddRow()
+	fmt.Println(row.AddMoney(12.5, "EUR").NumFmt)
+	row.AddString("CHF 1,234.50")
+	col := xlsxtra.NewCol(sheet, 1)
+	for _, header := range []string{"total", "text"} {
+		fmt.Println(col.Money(row, header))
+	}
+	// Output:
+	// [$€] #,##0.00
+	// EUR 12.50 <nil>
+	// CHF 1234.50 <nil>
+}
+
+func TestNumberParser_ParseMoney(t *testing.T) {
+	tests := []struct {
+		s    string
+		want xlsxtra.Money
+	}{
+		{"$ 1,234.50", xlsxtra.Money{Amount: 1234.5, Currency: "USD"}},
+		{"-£10", xlsxtra.Money{Amount: -10, Currency: "GBP"}},
+		{"(5 ¤)", xlsxtra.Money{Amount: -5, Currency: "¤"}},
+		{"7 NOK", xlsxtra.Money{Amount: 7, Currency: "NOK"}},
+		{"42", xlsxtra.Money{Amount: 42}},
+	}
+	for _, test := range tests {
+		got, err := xlsxtra.Numbers.ParseMoney(test.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Fatalf("ParseMoney(%q): got %v; want %v",
+				test.s, got, test.want)
+		}
+	}
+	_, err := xlsxtra.Numbers.ParseMoney("EUR")
+	if err == nil {
+		t.Fatal("ParseMoney: expected error")
+	}
+	if got := xlsxtra.MoneyFormat("NOK"); got != "[$NOK] #,##0.00" {
+		t.Fatalf("MoneyFormat: got %q", got)
+	}
+}
+
+func TestCol_Money(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Invoices")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("total")
+	col := xlsxtra.NewCol(sheet, 1)
+	for format, want := range map[string]string{
+		`"$"#,##0.00`:                           "USD",
+		`\$#,##0_);(\$#,##0)`:                   "USD",
+		`"€"\ #,##0.00`:                         "EUR",
+		`#,##0.00\ "eur"`:                       "EUR",
+		`[$£-809]#,##0.00`:                      "GBP",
+		`[$eur] #,##0.00`:                       "EUR",
+		`_("$"* #,##0.00_);_("$"* \(#,##0.00\)`: "USD",
+		`₹ #,##0`:                               "INR",
+		`#,##0.00`:                              "",
+		`0.00" pcs"`:                            "",
+		`[red]#,##0.00`:                         "",
+	} {
+		row := sheet.AddRow()
+		row.AddFloat(format, 42)
+		got, err := col.Money(row, "total")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Currency != want || got.Amount != 42 {
+			t.Errorf("Money(%q): got %v; want %s", format, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package xlsxtra
+
+import (
+	"strings"
+
+	"github.com/tealeg/xlsx"
+)
+
+// Name is a defined name of a workbook, such as "TaxRate"
+// for "Settings!$B$1". Sheet is the sheet to which the name
+// is local or empty for a name of the whole workbook.
+type Name struct {
+	Name  string
+	Ref   string
+	Sheet string
+}
+
+// Names returns the defined names of the file, which can be
+// used in place of a range by Sheet.Cell and Sheet.CellRange.
+// (The xlsx package reads a name local to the first sheet as
+// a name of the whole workbook and does not save defined
+// names, so names are read only.)
+func (f *File) Names() []Name {
+	names := make([]Name, len(f.DefinedNames))
+	for i, dn := range f.DefinedNames {
+		names[i] = Name{
+			Name:  dn.Name,
+			Ref:   dn.Data,
+			Sheet: localSheet(f.File, dn.LocalSheetID),
+		}
+	}
+	return names
+}
+
+// localSheet returns the name of the sheet of a local sheet
+// id or an empty string for the workbook.
+func localSheet(f *xlsx.File, id int) string {
+	if f == nil || id <= 0 || id >= len(f.Sheets) {
+		return ""
+	}
+	return f.Sheets[id].Name
+}
+
+// lookupName returns the reference of a defined name. A name
+// local to the sheet takes precedence over a workbook name.
+func lookupName(f *xlsx.File, name, sheet string) (string,
+	bool) {
+	if f == nil {
+		return "", false
+	}
+	ref, ok := "", false
+	for _, dn := range f.DefinedNames {
+		if !strings.EqualFold(dn.Name, name) {
+			continue
+		}
+		switch localSheet(f, dn.LocalSheetID) {
+		case sheet:
+			return dn.Data, true
+		case "":
+			ref, ok = dn.Data, true
+		}
+	}
+	return ref, ok
+}
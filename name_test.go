@@ -0,0 +1,143 @@
+package xlsxtra_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+	"github.com/tealeg/xlsx"
+)
+
+// withNames returns a copy of a file with defined names, such
+// as `<definedName name="Total">Sheet1!$A$1</definedName>`.
+// (The xlsx package does not write defined names, so they are
+// added to the saved workbook.)
+func withNames(f *xlsxtra.File, names ...string) (*xlsxtra.File,
+	error) {
+	var in, out bytes.Buffer
+	if err := f.Write(&in); err != nil {
+		return nil, err
+	}
+	r, err := zip.NewReader(bytes.NewReader(in.Bytes()),
+		int64(in.Len()))
+	if err != nil {
+		return nil, err
+	}
+	w := zip.NewWriter(&out)
+	for _, file := range r.File {
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		if file.Name == "xl/workbook.xml" {
+			data = bytes.Replace(data,
+				[]byte("<definedNames></definedNames>"),
+				[]byte("<definedNames>"+strings.Join(names, "")+
+					"</definedNames>"), 1)
+		}
+		fw, err := w.Create(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = fw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	c, err := xlsx.OpenBinary(out.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxtra.File{File: c}, nil
+}
+
+func ExampleFile_Names() {
+	f := xlsxtra.NewFile()
+	sheet, err := f.AddSheet("Settings")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("tax rate")
+	sheet.Row(1).AddFloat("0.00", 0.21)
+	f, err = withNames(f,
+		`<definedName name="TaxRate">Settings!$B$1</definedName>`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	cell, err := f.SheetByIndex(0).Cell("TaxRate")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(cell.Value)
+	fmt.Printf("%+v\n", f.Names())
+	// Output:
+	// 0.21
+	// [{Name:TaxRate Ref:Settings!$B$1 Sheet:}]
+}
+
+func TestFile_Names(t *testing.T) {
+	f := xlsxtra.NewFile()
+	input, err := f.AddSheet("Q1 Data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := f.AddSheet("Other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for r := 0; r < 3; r++ {
+		input.AddRow().AddInt(r, r*10)
+		other.AddRow().AddInt(r)
+	}
+	f, err = withNames(f,
+		`<definedName name="InputTable">'Q1 Data'!$A$2:$B$3</definedName>`,
+		`<definedName name="Total">A1</definedName>`,
+		`<definedName name="Total" localSheetId="1">A3</definedName>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, other = f.SheetByIndex(0), f.SheetByIndex(1)
+	cells, err := input.CellRange("inputtable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 2 || cells[1][1].Value != "20" {
+		t.Fatalf("CellRange: got %v", cells)
+	}
+	_, err = other.CellRange("InputTable")
+	if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+		t.Fatalf("CellRange: got %v", err)
+	}
+	// local name takes precedence
+	cell, err := other.Cell("Total")
+	if err != nil || cell.Value != "2" {
+		t.Fatalf("Cell: got %v, %v", cell, err)
+	}
+	cell, err = input.Cell("Total")
+	if err != nil || cell.Value != "0" {
+		t.Fatalf("Cell: got %v, %v", cell, err)
+	}
+	names := f.Names()
+	if len(names) != 3 || names[2].Sheet != "Other" ||
+		names[1].Sheet != "" {
+		t.Fatalf("Names: got %+v", names)
+	}
+	if _, err = other.Cell("Missing"); err == nil {
+		t.Error("Cell: expected error for undefined name")
+	}
+}
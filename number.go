@@ -0,0 +1,242 @@
+package xlsxtra
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tealeg/xlsx"
+)
+
+// NumberParser parses numbers formatted as text, such as
+// "1.234,56", "$ 1,234.56", "CHF 5", "12%", "(42)" or
+// "42-". Currency symbols and codes before or after the
+// number are ignored. Parentheses and a leading or trailing
+// minus make the number negative. A percentage is divided
+// by 100.
+//
+// If Decimal is empty, the decimal separator is detected: if
+// both "." and "," occur, the last one is the decimal
+// separator. A single "." is always a decimal separator, a
+// "," only if it occurs once. A single "," between one to
+// three digits (not starting with 0) and exactly three
+// digits, such as "1,234", is ambiguous and an error: use
+// NumbersUS or NumbersEU for such values. An apostrophe (as
+// in Switzerland) is accepted as thousands separator as well.
+//
+// Spaces are always allowed as thousands separator. All
+// thousands separators have to separate groups of three
+// digits.
+type NumberParser struct {
+	Decimal   string
+	Thousands string
+}
+
+// Number parsers for common locales
+var (
+	NumbersUS = &NumberParser{Decimal: ".", Thousands: ","}
+	NumbersEU = &NumberParser{Decimal: ",", Thousands: "."}
+	NumbersCH = &NumberParser{Decimal: ".", Thousands: "'"}
+)
+
+// Numbers is the number parser used by Col.Float, Col.Int
+// and the sort functions for text cells. (Numeric cells are
+// not affected.) By default it detects the decimal separator.
+var Numbers = &NumberParser{}
+
+// Parse a number. The error is a *strconv.NumError for the
+// original string.
+func (p *NumberParser) Parse(s string) (float64, error) {
+	f, _, ok := p.parse(s)
+	if !ok {
+		return math.NaN(), &strconv.NumError{
+			Func: "ParseFloat", Num: s, Err: strconv.ErrSyntax}
+	}
+	return f, nil
+}
+
+// ParseInt parses a number and truncates it to an integer.
+func (p *NumberParser) ParseInt(s string) (int, error) {
+	f, err := p.Parse(s)
+	if err != nil {
+		return -1, err
+	}
+	return int(f), nil
+}
+
+// parse a number and return the currency symbol or code
+// which was found.
+func (p *NumberParser) parse(s string) (float64, string,
+	bool) {
+	neg, pct := false, false
+	cur := ""
+	for {
+		t := strings.TrimFunc(s, unicode.IsSpace)
+		switch {
+		case strings.HasPrefix(t, "(") &&
+			strings.HasSuffix(t, ")"):
+			neg = !neg
+			t = t[1 : len(t)-1]
+		case strings.HasPrefix(t, "-"):
+			neg = !neg
+			t = t[1:]
+		case strings.HasSuffix(t, "-"):
+			neg = !neg
+			t = t[:len(t)-1]
+		case strings.HasPrefix(t, "+"):
+			t = t[1:]
+		case strings.HasSuffix(t, "%") && !pct:
+			pct = true
+			t = t[:len(t)-1]
+		default:
+			var c string
+			t, c = trimCurrency(t)
+			if c != "" {
+				cur = c
+			}
+		}
+		if t == s {
+			break
+		}
+		s = t
+	}
+	s, ok := p.normalize(s)
+	if !ok {
+		return 0, "", false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	if neg {
+		f = -f
+	}
+	if pct {
+		f /= 100
+	}
+	return f, cur, true
+}
+
+// normalize removes thousands separators and replaces the
+// decimal separator by a "."
+func (p *NumberParser) normalize(s string) (string, bool) {
+	dec, th := p.Decimal, p.Thousands
+	if dec == "" {
+		dot := strings.LastIndex(s, ".")
+		comma := strings.LastIndex(s, ",")
+		if dot < 0 && isAmbiguous(s) {
+			return "", false
+		}
+		switch {
+		case dot >= 0 && comma >= 0 && dot < comma,
+			dot < 0 && strings.Count(s, ",") == 1:
+			dec, th = ",", ".'"
+		default:
+			dec, th = ".", ",'"
+		}
+	}
+	var intPart, fracPart string
+	parts := strings.Split(s, dec)
+	switch len(parts) {
+	case 1:
+		intPart = parts[0]
+	case 2:
+		intPart, fracPart = parts[0], parts[1]
+	default:
+		return "", false
+	}
+	groups := strings.FieldsFunc(intPart, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(th, r)
+	})
+	if len(groups) == 0 {
+		if len(parts) != 2 || fracPart == "" {
+			return "", false
+		}
+		// ".5" as 0.5
+		groups = []string{"0"}
+	}
+	if len(groups) > 1 && len(groups[0]) > 3 {
+		return "", false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return "", false
+		}
+	}
+	s = strings.Join(groups, "")
+	if len(parts) == 2 {
+		s += "." + fracPart
+	}
+	return s, true
+}
+
+// isAmbiguous checks if a single "," could be a decimal as
+// well as a thousands separator, such as in "1,234".
+func isAmbiguous(s string) bool {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 || len(parts[1]) != 3 ||
+		len(parts[0]) < 1 || len(parts[0]) > 3 ||
+		parts[0][0] == '0' {
+		return false
+	}
+	return isDigits(parts[0]) && isDigits(parts[1])
+}
+
+// isNumeric checks if a cell stores a number, such as a
+// numeric, date or formula cell.
+func isNumeric(cell *xlsx.Cell) bool {
+	switch cell.Type() {
+	case xlsx.CellTypeNumeric, xlsx.CellTypeGeneral,
+		xlsx.CellTypeDate, xlsx.CellTypeFormula:
+		return true
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// trimCurrency removes currency symbols and ISO 4217 codes
+// (three upper case letters) before and after a number. The
+// removed currency is returned as well.
+func trimCurrency(s string) (string, string) {
+	isSymbol := func(r rune) bool {
+		return unicode.Is(unicode.Sc, r)
+	}
+	t := strings.TrimLeftFunc(s, isSymbol)
+	cur := s[:len(s)-len(t)]
+	s = strings.TrimRightFunc(t, isSymbol)
+	if cur == "" {
+		cur = t[len(s):]
+	}
+	if len(s) > 3 && isCode(s[:3]) && !isUpper(s[3]) {
+		cur = s[:3]
+		s = s[3:]
+	}
+	if n := len(s); n > 3 && isCode(s[n-3:]) &&
+		!isUpper(s[n-4]) {
+		cur = s[n-3:]
+		s = s[:n-3]
+	}
+	return s, cur
+}
+
+func isCode(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isUpper(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isUpper(b byte) bool {
+	return 'A' <= b && b <= 'Z'
+}
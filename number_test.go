@@ -0,0 +1,79 @@
+package xlsxtra_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleNumberParser_Parse() {
+	for _, s := range []string{
+		"1.234,56", "1,234.56", "(42)", "12%", "£10",
+		"CHF 5", "5 EUR", "42-", "€ -1 234,5"} {
+		fmt.Println(xlsxtra.Numbers.Parse(s))
+	}
+	// Output:
+	// 1234.56 <nil>
+	// 1234.56 <nil>
+	// -42 <nil>
+	// 0.12 <nil>
+	// 10 <nil>
+	// 5 <nil>
+	// 5 <nil>
+	// -42 <nil>
+	// -1234.5 <nil>
+}
+
+func TestNumberParser(t *testing.T) {
+	tests := []struct {
+		p    *xlsxtra.NumberParser
+		s    string
+		want float64
+	}{
+		{xlsxtra.Numbers, "3.14", 3.14},
+		{xlsxtra.Numbers, ".5", 0.5},
+		{xlsxtra.Numbers, "-.5", -0.5},
+		{xlsxtra.NumbersEU, ",5", 0.5},
+		{xlsxtra.Numbers, "1e-06", 1e-06},
+		{xlsxtra.Numbers, "2,50", 2.5},
+		{xlsxtra.Numbers, "0,125", 0.125},
+		{xlsxtra.Numbers, "1234,567", 1234.567},
+		{xlsxtra.Numbers, "CHF 1'234.50", 1234.5},
+		{xlsxtra.Numbers, "1'234'567", 1234567},
+		{xlsxtra.Numbers, "1,234,567", 1234567},
+		{xlsxtra.Numbers, "+7 USD", 7},
+		{xlsxtra.NumbersUS, "1,234", 1234},
+		{xlsxtra.NumbersEU, "1.234", 1234},
+		{xlsxtra.NumbersEU, "(1.234,5 €)", -1234.5},
+		{xlsxtra.NumbersCH, "1'234.5", 1234.5},
+	}
+	for _, test := range tests {
+		got, err := test.p.Parse(test.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Fatalf("Parse(%q): got %v; want %v",
+				test.s, got, test.want)
+		}
+	}
+	for _, s := range []string{
+		"", ".", "-", "abc", "monday, tuesday", "Room 101", "1.2.3",
+		"1,2,3", "1234,567.5", "Q1 2017", "12%%",
+		// ambiguous: 1234 in the US, 1.234 in Europe
+		"1,234", "-12,345", "$ 999,000",
+		"12'34"} {
+		_, err := xlsxtra.Numbers.Parse(s)
+		if err == nil {
+			t.Fatalf("Parse(%q): expected error", s)
+		}
+	}
+	i, err := xlsxtra.NumbersEU.ParseInt("1.234,9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != 1234 {
+		t.Fatalf("ParseInt: got %d; want 1234", i)
+	}
+}
@@ -1,10 +1,92 @@
 package xlsxtra
 
-import "github.com/tealeg/xlsx"
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/tealeg/xlsx"
+)
 
 // Row of a sheet
 type Row struct {
 	*xlsx.Row
+	num int // one based index in the sheet, if known
+}
+
+// CellMarshaler is implemented by types which can set
+// their own value in a cell, for use with Row.Add.
+type CellMarshaler interface {
+	MarshalCell(cell *xlsx.Cell)
+}
+
+// Add adds cells to a row with the type of the values: a
+// string, an integer, a float, a bool, a time.Time, a
+// time.Duration, Money, nil (an empty cell), a CellMarshaler
+// or a fmt.Stringer. Pointers are dereferenced; a nil pointer
+// gives an empty cell. Named types are added by their kind,
+// such as a number for "type Qty int". Other values are
+// formatted with fmt.Sprint.
+func (row *Row) Add(values ...interface{}) *xlsx.Cell {
+	var cell *xlsx.Cell
+	for _, value := range values {
+		cell = row.add(value)
+	}
+	return cell
+}
+
+// add adds a cell with one value (see Add)
+func (row *Row) add(value interface{}) *xlsx.Cell {
+	rv := reflect.ValueOf(value)
+	if _, ok := value.(CellMarshaler); !ok &&
+		rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return row.AddCell()
+		}
+		return row.add(rv.Elem().Interface())
+	}
+	switch v := value.(type) {
+	case nil:
+		return row.AddCell()
+	case time.Time:
+		return row.AddTime(v)
+	case time.Duration:
+		return row.AddDuration(v)
+	case Money:
+		return row.AddMoney(v.Amount, v.Currency)
+	case CellMarshaler:
+		cell := row.AddCell()
+		v.MarshalCell(cell)
+		return cell
+	case fmt.Stringer:
+		return row.AddString(v.String())
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return row.AddString(rv.String())
+	case reflect.Bool:
+		return row.AddBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Int32, reflect.Int64:
+		cell := row.AddCell()
+		cell.SetInt64(rv.Int())
+		return cell
+	case reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		cell := row.AddCell()
+		if u := rv.Uint(); u > math.MaxInt64 {
+			cell.SetFloat(float64(u))
+		} else {
+			cell.SetInt64(int64(u))
+		}
+		return cell
+	case reflect.Float32, reflect.Float64:
+		cell := row.AddCell()
+		cell.SetFloat(rv.Float())
+		return cell
+	}
+	return row.AddString(fmt.Sprint(value))
 }
 
 // AddBool adds a cell with bool as 1 or 0 to a row
@@ -20,6 +102,31 @@ func (row *Row) AddBool(x ...bool) *xlsx.Cell {
 	return cell
 }
 
+// AddDate adds a cell with the date of a time to a row,
+// formatted with DateFormat
+func (row *Row) AddDate(x ...time.Time) *xlsx.Cell {
+	var cell *xlsx.Cell
+	for _, y := range x {
+		y = time.Date(y.Year(), y.Month(), y.Day(), 0, 0, 0, 0,
+			time.UTC)
+		cell = row.AddCell()
+		cell.SetDateTimeWithFormat(
+			ExcelTime(y, row.date1904()), DateFormat)
+	}
+	return cell
+}
+
+// AddDuration adds a cell with a duration as a fraction of
+// days to a row, formatted with DurationFormat
+func (row *Row) AddDuration(x ...time.Duration) *xlsx.Cell {
+	var cell *xlsx.Cell
+	for _, y := range x {
+		cell = row.AddCell()
+		cell.SetFloatWithFormat(y.Hours()/24, DurationFormat)
+	}
+	return cell
+}
+
 // AddEmpty adds n empty cells to a row
 func (row *Row) AddEmpty(n int) {
 	for i := 0; i < n; i++ {
@@ -50,6 +157,15 @@ func (row *Row) AddFormula(format string,
 	return cell
 }
 
+// AddHyperlink adds a cell with a link to a row (see
+// HyperlinkFormula). The text is kept as the value of the
+// cell, for readers which do not evaluate formulas.
+func (row *Row) AddHyperlink(text, target string) *xlsx.Cell {
+	cell := row.AddFormula("", HyperlinkFormula(target, text))
+	cell.Value = text
+	return cell
+}
+
 // AddInt adds a cell with int value to a row
 func (row *Row) AddInt(x ...int) *xlsx.Cell {
 	var cell *xlsx.Cell
@@ -60,6 +176,14 @@ func (row *Row) AddInt(x ...int) *xlsx.Cell {
 	return cell
 }
 
+// AddMoney adds a cell with an amount to a row, formatted
+// with the currency (an ISO 4217 code or a symbol) by
+// MoneyFormat
+func (row *Row) AddMoney(amount float64,
+	currency string) *xlsx.Cell {
+	return row.AddFloat(MoneyFormat(currency), amount)
+}
+
 // AddString adds a cell with string value to a row
 func (row *Row) AddString(x ...string) *xlsx.Cell {
 	var cell *xlsx.Cell
@@ -70,6 +194,53 @@ func (row *Row) AddString(x ...string) *xlsx.Cell {
 	return cell
 }
 
+// AddTime adds a cell with date and time to a row,
+// formatted with TimeFormat
+func (row *Row) AddTime(x ...time.Time) *xlsx.Cell {
+	var cell *xlsx.Cell
+	for _, y := range x {
+		cell = row.AddCell()
+		cell.SetDateTimeWithFormat(
+			ExcelTime(y, row.date1904()), TimeFormat)
+	}
+	return cell
+}
+
+// index returns the one based index of the row in its
+// sheet or 0 if it is not found. The sheet is only searched
+// if the index is unknown or the rows were reordered. (A row
+// of Sheet.RowMerged is not in the sheet: its index is used.)
+func (row *Row) index() int {
+	if row.Sheet == nil {
+		return 0
+	}
+	if n := row.num; n > 0 && n <= len(row.Sheet.Rows) &&
+		row.Sheet.Rows[n-1] == row.Row {
+		return n
+	}
+	for i, r := range row.Sheet.Rows {
+		if r == row.Row {
+			return i + 1
+		}
+	}
+	return row.num
+}
+
+// sheetName returns the name of the sheet of the row
+func (row *Row) sheetName() string {
+	if row.Sheet == nil {
+		return ""
+	}
+	return row.Sheet.Name
+}
+
+// date1904 checks if the file of the row uses the 1904 date
+// system.
+func (row *Row) date1904() bool {
+	return row.Sheet != nil && row.Sheet.File != nil &&
+		row.Sheet.File.Date1904
+}
+
 // SetStyle set style to all cells of a row
 func (row *Row) SetStyle(style *xlsx.Style) {
 	for _, cell := range row.Cells {
@@ -90,7 +261,7 @@ func ToString(cells []*xlsx.Cell) []string {
 func Rows(rows []*xlsx.Row) []*Row {
 	r := make([]*Row, len(rows))
 	for i, row := range rows {
-		r[i] = &Row{row}
+		r[i] = &Row{Row: row}
 	}
 	return r
 }
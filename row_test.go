@@ -2,6 +2,8 @@ package xlsxtra_test
 
 import (
 	"fmt"
+	"testing"
+	"time"
 
 	"github.com/stanim/xlsxtra"
 	"github.com/tealeg/xlsx"
@@ -21,3 +23,74 @@ func ExampleToString() {
 	// Output:
 	// [Rob Robert Ken]
 }
+
+func ExampleRow_AddTime() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	row := sheet.AddRow()
+	t := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, cell := range []*xlsx.Cell{
+		row.AddDate(t),
+		row.AddTime(t),
+		row.AddDuration(36 * time.Hour),
+	} {
+		fmt.Println(cell.Value, cell.NumFmt)
+	}
+	// Output:
+	// 42736 yyyy-mm-dd
+	// 42736.5 yyyy-mm-dd hh:mm:ss
+	// 1.5 [h]:mm:ss
+}
+
+type point struct{ x, y int }
+
+func (p point) MarshalCell(cell *xlsx.Cell) {
+	cell.SetString(fmt.Sprintf("(%d,%d)", p.x, p.y))
+}
+
+func ExampleRow_Add() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	row := sheet.AddRow()
+	row.Add("text", 1, int8(-2), uint64(3), 2.5, float32(0.5),
+		true, nil, time.Second*90, point{1, 2}, time.March,
+		[]int{4})
+	fmt.Printf("%q\n", xlsxtra.ToString(row.Cells))
+	// Output:
+	// ["text" "1" "-2" "3" "2.5" "0.5" "1" "" "0.0010416666666666667" "(1,2)" "March" "[4]"]
+}
+
+type qty int
+
+type status string
+
+func TestRow_Add(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := sheet.AddRow()
+	n := 7
+	d := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+	var missing *int
+	var never *time.Time
+	row.Add(&n, missing, &d, never, qty(3), status("open"),
+		float32(1.5), &point{1, 2})
+	want := []string{"7", "", "42736.5", "", "3", "open", "1.5",
+		"(1,2)"}
+	got := xlsxtra.ToString(row.Cells)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Add: got %q; want %q", got, want)
+	}
+	if row.Cells[2].NumFmt != xlsxtra.TimeFormat ||
+		row.Cells[4].Type() == xlsx.CellTypeString {
+		t.Fatalf("Add: got format %q, type %v",
+			row.Cells[2].NumFmt, row.Cells[4].Type())
+	}
+}
@@ -0,0 +1,195 @@
+package xlsxtra
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/tealeg/xlsx"
+)
+
+// Type of the values of a column in a Schema
+type Type int
+
+// Column value types which can be checked by a Schema
+const (
+	TypeString Type = iota
+	TypeInt
+	TypeFloat
+	TypeBool
+)
+
+// Field declares the constraints of one column of a Schema.
+// The header title is always required in the header row.
+type Field struct {
+	Header   string
+	Type     Type           // TypeBool is checked strictly
+	Required bool           // value may not be empty
+	Unique   bool           // value may only occur once
+	Min, Max *float64       // bounds for TypeInt & TypeFloat
+	Pattern  *regexp.Regexp // applied to the string value
+}
+
+// Schema declares the columns of a sheet.
+type Schema []Field
+
+// Violation of a Schema. Coord is empty for a missing
+// header.
+type Violation struct {
+	Coord   string
+	Row     int
+	Header  string
+	Value   string
+	Message string
+}
+
+// Error implements the error interface
+func (v *Violation) Error() string {
+	if v.Coord == "" {
+		return fmt.Sprintf("row %d: %s: %s",
+			v.Row, v.Header, v.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s (%q)",
+		v.Coord, v.Header, v.Message, v.Value)
+}
+
+// Validate checks all rows below the header row and returns
+// every violation. Rows of which all cells are empty are
+// skipped. A nil slice means the sheet is valid.
+func (s Schema) Validate(sheet *Sheet, headerRow int) []*Violation {
+	var violations []*Violation
+	col := NewCol(sheet, headerRow)
+	var fields []Field
+	for _, field := range s {
+		if _, err := col.Index(field.Header); err != nil {
+			violations = append(violations, &Violation{
+				Row:     headerRow,
+				Header:  field.Header,
+				Message: "missing column header",
+			})
+			continue
+		}
+		fields = append(fields, field)
+	}
+	seen := make([]map[string]string, len(fields))
+	for i := range seen {
+		seen[i] = make(map[string]string)
+	}
+	for r := headerRow + 1; r <= len(sheet.Rows); r++ {
+		row := sheet.Row(r)
+		if isBlank(row) {
+			continue
+		}
+		for i, field := range fields {
+			v := field.check(col, row, r)
+			if v == nil {
+				continue
+			}
+			if v.Message == "" && field.Unique {
+				if first, ok := seen[i][v.Value]; ok {
+					v.Message = fmt.Sprintf(
+						"duplicate value (first in %s)", first)
+				} else {
+					seen[i][v.Value] = v.Coord
+				}
+			}
+			if v.Message != "" {
+				violations = append(violations, v)
+			}
+		}
+	}
+	return violations
+}
+
+// check validates a field in a row. It returns nil if the
+// cell is empty and not required, otherwise a Violation
+// with an empty Message if the value is valid.
+func (f Field) check(col Col, row *Row, r int) *Violation {
+	i, _ := col.Index(f.Header)
+	v := &Violation{
+		Coord:  Coord(i, r),
+		Row:    r,
+		Header: f.Header,
+	}
+	val := ""
+	if i <= len(row.Cells) {
+		val, _ = row.Cells[i-1].String()
+	}
+	v.Value = val
+	if strings.TrimSpace(val) == "" {
+		if !f.Required {
+			return nil
+		}
+		v.Message = "required value is empty"
+		return v
+	}
+	var (
+		nr  float64
+		err error
+	)
+	switch f.Type {
+	case TypeInt:
+		nr, err = col.Float(row, f.Header)
+		if err == nil && nr != math.Trunc(nr) {
+			err = errors.New("not an integer")
+		}
+	case TypeFloat:
+		nr, err = col.Float(row, f.Header)
+	case TypeBool:
+		_, err = Bools.strict().Parse(val)
+	}
+	switch {
+	case err != nil:
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			err = perr.Err
+		}
+		v.Message = fmt.Sprintf("invalid value: %v", err)
+	case f.Min != nil && (f.Type == TypeInt ||
+		f.Type == TypeFloat) && nr < *f.Min:
+		v.Message = fmt.Sprintf("value below minimum %v", *f.Min)
+	case f.Max != nil && (f.Type == TypeInt ||
+		f.Type == TypeFloat) && nr > *f.Max:
+		v.Message = fmt.Sprintf("value above maximum %v", *f.Max)
+	case f.Pattern != nil && !f.Pattern.MatchString(val):
+		v.Message = fmt.Sprintf("value does not match %q",
+			f.Pattern.String())
+	}
+	return v
+}
+
+// isBlank checks if all cells of a row are empty
+func isBlank(row *Row) bool {
+	for _, cell := range row.Cells {
+		if strings.TrimSpace(cell.Value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Highlight applies a style (for example a red fill created
+// with NewStyle) to the cells of the violations. Rows which
+// are too short are extended with empty cells. Use it on a
+// copy of the file (see File.Copy) to keep the original
+// intact.
+func Highlight(sheet *Sheet, violations []*Violation,
+	style *xlsx.Style) error {
+	for _, v := range violations {
+		if v.Coord == "" {
+			continue
+		}
+		colS, r, err := SplitCoord(v.Coord)
+		if err != nil {
+			return fmt.Errorf("Highlight: %v", err)
+		}
+		row := sheet.Row(r)
+		if n := StrCol[colS] - len(row.Cells); n > 0 {
+			row.AddEmpty(n)
+		}
+		row.Cells[StrCol[colS]-1].SetStyle(style)
+	}
+	return nil
+}
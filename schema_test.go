@@ -0,0 +1,103 @@
+package xlsxtra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func newSchemaSheet() *xlsxtra.Sheet {
+	sheet, _ := xlsxtra.NewFile().AddSheet("Orders")
+	header := sheet.AddRow()
+	header.AddString("id", "email", "amount")
+	data := [][]string{
+		{"1", "a@example.com", "5"},
+		{"2", "b@example", "-1"},
+		{"2", "c@example.com", "x"},
+		{"", "", ""},
+		{"4"},
+	}
+	for _, d := range data {
+		row := sheet.AddRow()
+		row.AddString(d...)
+	}
+	return sheet
+}
+
+func ExampleSchema_Validate() {
+	zero := 0.0
+	schema := xlsxtra.Schema{
+		{Header: "id", Type: xlsxtra.TypeInt, Required: true,
+			Unique: true},
+		{Header: "email", Required: true,
+			Pattern: regexp.MustCompile(`@.+\..+$`)},
+		{Header: "amount", Type: xlsxtra.TypeFloat, Min: &zero},
+		{Header: "date"},
+	}
+	sheet := newSchemaSheet()
+	for _, v := range schema.Validate(sheet, 1) {
+		fmt.Println(v)
+	}
+	// Output:
+	// row 1: date: missing column header
+	// B3: email: value does not match "@.+\\..+$" ("b@example")
+	// C3: amount: value below minimum 0 ("-1")
+	// A4: id: duplicate value (first in A3) ("2")
+	// C4: amount: invalid value: strconv.ParseFloat: parsing "x": invalid syntax ("x")
+	// B6: email: required value is empty ("")
+}
+
+func TestSchema_Validate_int(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("n")
+	sheet.AddRow().AddString("2.5")
+	sheet.AddRow().AddFloat("0.0", 3)
+	max := 2.0
+	schema := xlsxtra.Schema{
+		{Header: "n", Type: xlsxtra.TypeInt, Max: &max},
+	}
+	var got []string
+	for _, v := range schema.Validate(sheet, 1) {
+		got = append(got, fmt.Sprint(v))
+	}
+	want := []string{
+		`A2: n: invalid value: not an integer ("2.5")`,
+		`A3: n: value above maximum 2 ("3")`,
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Validate: got %q; want %q", got, want)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	sheet := newSchemaSheet()
+	schema := xlsxtra.Schema{
+		{Header: "amount", Required: true},
+	}
+	violations := schema.Validate(sheet, 1)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations; want 1", len(violations))
+	}
+	style := xlsxtra.NewStyle("00ff0000", nil, nil, nil)
+	err := xlsxtra.Highlight(sheet, violations, style)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell, err := sheet.Cell("C6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cell.GetStyle() != style {
+		t.Fatal("Highlight: expected style to be set on C6")
+	}
+	err = xlsxtra.Highlight(sheet, []*xlsxtra.Violation{
+		{Coord: "invalid"}}, style)
+	if err == nil {
+		t.Fatal("Highlight: expected error for invalid coord")
+	}
+}
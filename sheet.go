@@ -1,7 +1,10 @@
 package xlsxtra
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/tealeg/xlsx"
 )
@@ -25,12 +28,13 @@ func OpenSheet(fn string, name string) (
 
 // AddRow adds a row to a sheet
 func (sheet *Sheet) AddRow() *Row {
-	return &Row{Row: sheet.Sheet.AddRow()}
+	row := sheet.Sheet.AddRow()
+	return &Row{Row: row, num: len(sheet.Rows)}
 }
 
 // Row returns one based row
 func (sheet *Sheet) Row(row int) *Row {
-	return &Row{Row: sheet.Rows[row-1]}
+	return &Row{Row: sheet.Rows[row-1], num: row}
 }
 
 // RowRange return all rows.
@@ -43,56 +47,183 @@ func (sheet *Sheet) RowRange(start, end int) []*Row {
 		end += n + 1
 	}
 	start--
-	return Rows(sheet.Rows[start:end])
+	rows := Rows(sheet.Rows[start:end])
+	for i, row := range rows {
+		row.num = start + i + 1
+	}
+	return rows
 }
 
 func (sheet *Sheet) checkCell(col, row int) (
 	*xlsx.Row, error) {
-	n := len(sheet.Rows)
-	if row > n {
-		return nil, fmt.Errorf(
-			"checkCell: row %d out of range of sheet (max %d)",
-			row, n)
+	err := &RangeError{
+		Sheet:  sheet.Name,
+		Coord:  Coord(col, row),
+		Row:    row,
+		Col:    col,
+		MaxRow: len(sheet.Rows),
+	}
+	if row > err.MaxRow {
+		return nil, fmt.Errorf("checkCell: %w", err)
 	}
 	r := sheet.Rows[row-1]
-	n = len(r.Cells)
-	if col > n {
-		return nil, fmt.Errorf(
-			"checkCell: column %d out of range (max %d)",
-			col, n)
+	err.MaxCol = len(r.Cells)
+	if col > err.MaxCol {
+		return nil, fmt.Errorf("checkCell: %w", err)
 	}
 	return r, nil
 }
 
-// Cell returns a cell based on coordinate string.
+// resolve replaces a defined name by its range (see
+// File.Names) and removes the sheet from a sheet
+// qualified reference, such as "'Q1 Data'!A1:B9". A reference
+// to another sheet is an error.
+func (sheet *Sheet) resolve(ref string) (string, error) {
+	if !reRange.MatchString(ref) {
+		if target, ok := lookupName(sheet.File, ref,
+			sheet.Name); ok {
+			ref = target
+		}
+	}
+	name, rg := splitSheetRef(ref)
+	if name != "" && name != sheet.Name {
+		return "", fmt.Errorf("%q refers to sheet %q: %w",
+			rg, name, &CoordError{Sheet: sheet.Name, Ref: ref,
+				Range: true})
+	}
+	return rg, nil
+}
+
+// Bounds converts a range into boundaries like RangeBounds,
+// but also accepts whole columns ("A:C") and whole rows
+// ("2:5"), which are limited to the used range of the sheet.
+// A whole column or row range outside of the used range gives
+// a *RangeError.
+func (sheet *Sheet) Bounds(rg string) (int, int, int, int,
+	error) {
+	minCol, minRow, maxCol, maxRow, ok := sheet.wholeBounds(rg)
+	if !ok {
+		return RangeBounds(rg)
+	}
+	if n := sheet.maxCol(); maxCol > n {
+		maxCol = n
+	}
+	if n := len(sheet.Rows); maxRow > n {
+		maxRow = n
+	}
+	if minCol > maxCol || minRow > maxRow {
+		return 0, 0, 0, 0, &RangeError{
+			Sheet:  sheet.Name,
+			Coord:  rg,
+			Row:    minRow,
+			Col:    minCol,
+			MaxRow: len(sheet.Rows),
+			MaxCol: sheet.maxCol(),
+		}
+	}
+	return minCol, minRow, maxCol, maxRow, nil
+}
+
+// wholeBounds converts whole columns ("A:C") or whole rows
+// ("2:5") into boundaries without limits. ok is false for
+// other ranges.
+func (sheet *Sheet) wholeBounds(rg string) (minCol, minRow,
+	maxCol, maxRow int, ok bool) {
+	if m := reColRange.FindStringSubmatch(rg); m != nil {
+		minCol, ok1 := StrCol[m[1]]
+		maxCol, ok2 := StrCol[m[2]]
+		if ok1 && ok2 && minCol <= maxCol {
+			return minCol, 1, maxCol, math.MaxInt32, true
+		}
+	}
+	if m := reRowRange.FindStringSubmatch(rg); m != nil {
+		minRow, err1 := strconv.Atoi(m[1])
+		maxRow, err2 := strconv.Atoi(m[2])
+		if err1 == nil && err2 == nil && minRow <= maxRow {
+			return 1, minRow, math.MaxInt32, maxRow, true
+		}
+	}
+	return 0, 0, 0, 0, false
+}
+
+// Cell returns a cell based on coordinate string or a
+// defined name.
 func (sheet *Sheet) Cell(coord string) (
 	*xlsx.Cell, error) {
+	coord, err := sheet.resolve(coord)
+	if err != nil {
+		return nil, fmt.Errorf("Cell: %w", err)
+	}
 	colS, row, err := SplitCoord(coord)
 	if err != nil {
-		return nil, fmt.Errorf("checkCell: %v", err)
+		return nil, fmt.Errorf("checkCell: %w",
+			withSheet(err, sheet.Name))
 	}
 	col, ok := StrCol[colS]
 	if !ok {
-		return nil, fmt.Errorf("checkCell: column %q overflow",
-			colS)
+		return nil, fmt.Errorf("checkCell: column overflow: %w",
+			&CoordError{Sheet: sheet.Name, Ref: coord})
 	}
 	r, err := sheet.checkCell(col, row)
 	if err != nil {
-		return nil, fmt.Errorf("Cell: %v", err)
+		return nil, fmt.Errorf("Cell: %w", err)
 	}
 	return r.Cells[col-1], nil
 }
 
-// CellRange returns all cells by row
+// SetHyperlink turns the text of a cell into a link (see
+// HyperlinkFormula). The text is kept as the value of the
+// cell, for readers which do not evaluate formulas.
+func (sheet *Sheet) SetHyperlink(coord, target string) error {
+	cell, err := sheet.Cell(coord)
+	if err != nil {
+		return fmt.Errorf("SetHyperlink: %w", err)
+	}
+	cell.SetFormula(HyperlinkFormula(target, cell.Value))
+	return nil
+}
+
+// Hyperlink returns the target of the link of a cell or an
+// empty string if it has no link.
+func (sheet *Sheet) Hyperlink(coord string) (string, error) {
+	cell, err := sheet.Cell(coord)
+	if err != nil {
+		return "", fmt.Errorf("Hyperlink: %w", err)
+	}
+	return HyperlinkTarget(cell.Formula()), nil
+}
+
+// CellRange returns all cells by row of a range, a whole
+// column or row range (see Bounds), a sheet qualified range of
+// this sheet or a defined name. For whole columns or rows,
+// rows which are too short give empty cells, which are not
+// added to the sheet, and a range outside of the used range
+// gives no cells. Otherwise rows which are too short are an
+// error.
 func (sheet *Sheet) CellRange(rg string) (
 	[][]*xlsx.Cell, error) {
-	minCol, minRow, maxCol, maxRow, err := RangeBounds(rg)
+	rg, err := sheet.resolve(rg)
 	if err != nil {
-		return nil, fmt.Errorf("CellRange: %v", err)
+		return nil, fmt.Errorf("CellRange: %w", err)
+	}
+	_, _, _, _, whole := sheet.wholeBounds(rg)
+	minCol, minRow, maxCol, maxRow, err := sheet.Bounds(rg)
+	if whole && errors.Is(err, ErrOutOfRange) {
+		return [][]*xlsx.Cell{}, nil
 	}
-	_, err = sheet.checkCell(maxCol, maxRow)
 	if err != nil {
-		return nil, fmt.Errorf("CellRange: %v", err)
+		return nil, fmt.Errorf("CellRange: %w",
+			withSheet(err, sheet.Name))
+	}
+	if maxCol < minCol || maxRow < minRow {
+		return nil, fmt.Errorf("CellRange: %w", &CoordError{
+			Sheet: sheet.Name, Ref: rg, Range: true})
+	}
+	for r := minRow; r <= maxRow && !whole; r++ {
+		_, err = sheet.checkCell(maxCol, r)
+		if err != nil {
+			return nil, fmt.Errorf("CellRange: %w", err)
+		}
 	}
 	rows := sheet.Rows
 	nRow := maxRow - minRow + 1
@@ -102,6 +233,10 @@ func (sheet *Sheet) CellRange(rg string) (
 		row := rows[r-1]
 		cells := make([]*xlsx.Cell, nCol)
 		for col := minCol; col <= maxCol; col++ {
+			if col > len(row.Cells) {
+				cells[col-minCol] = &xlsx.Cell{Row: row}
+				continue
+			}
 			cells[col-minCol] = row.Cells[col-1]
 		}
 		result[r-minRow] = cells
@@ -109,6 +244,149 @@ func (sheet *Sheet) CellRange(rg string) (
 	return result, nil
 }
 
+// mergedBounds returns the boundaries of all merged ranges
+// as min_col, min_row, max_col, max_row.
+func (sheet *Sheet) mergedBounds() [][4]int {
+	var bounds [][4]int
+	for r, row := range sheet.Rows {
+		for c, cell := range row.Cells {
+			if cell.HMerge > 0 || cell.VMerge > 0 {
+				bounds = append(bounds, [4]int{c + 1, r + 1,
+					c + 1 + cell.HMerge, r + 1 + cell.VMerge})
+			}
+		}
+	}
+	return bounds
+}
+
+// Merge cells of a range. Missing cells are added. Ranges
+// which overlap with an existing merge are an error.
+func (sheet *Sheet) Merge(rg string) error {
+	minCol, minRow, maxCol, maxRow, err := RangeBounds(rg)
+	if err != nil {
+		return fmt.Errorf("Merge: %w", withSheet(err, sheet.Name))
+	}
+	if minCol > maxCol || minRow > maxRow {
+		return fmt.Errorf("Merge: %w", &CoordError{
+			Sheet: sheet.Name, Ref: rg, Range: true})
+	}
+	if minCol == maxCol && minRow == maxRow {
+		return fmt.Errorf("Merge: range %q is a single cell", rg)
+	}
+	for _, b := range sheet.mergedBounds() {
+		if minCol <= b[2] && b[0] <= maxCol &&
+			minRow <= b[3] && b[1] <= maxRow {
+			return fmt.Errorf("Merge: range %q overlaps %q",
+				rg, rangeRef(b[0], b[1], b[2], b[3]))
+		}
+	}
+	for r := minRow; r <= maxRow; r++ {
+		sheet.Sheet.Cell(r-1, maxCol-1)
+	}
+	sheet.Sheet.Cell(minRow-1, minCol-1).Merge(
+		maxCol-minCol, maxRow-minRow)
+	return nil
+}
+
+// Unmerge a merged range.
+func (sheet *Sheet) Unmerge(rg string) error {
+	ref, ok := sheet.MergedAt(rg)
+	if !ok || Abs(ref) != Abs(rg) {
+		return fmt.Errorf("Unmerge: range %q is not merged", rg)
+	}
+	minCol, minRow, _, _, _ := RangeBounds(ref)
+	sheet.Rows[minRow-1].Cells[minCol-1].Merge(0, 0)
+	return nil
+}
+
+// MergedRanges returns all merged ranges of the sheet.
+func (sheet *Sheet) MergedRanges() []string {
+	var ranges []string
+	for _, b := range sheet.mergedBounds() {
+		ranges = append(ranges, rangeRef(b[0], b[1], b[2], b[3]))
+	}
+	return ranges
+}
+
+// MergedAt returns the merged range which contains a cell,
+// or false if the cell is not merged. (For a range, its
+// first cell is used.)
+func (sheet *Sheet) MergedAt(coord string) (string, bool) {
+	col, row, _, _, err := RangeBounds(coord)
+	if err != nil {
+		return "", false
+	}
+	for _, b := range sheet.mergedBounds() {
+		if b[0] <= col && col <= b[2] &&
+			b[1] <= row && row <= b[3] {
+			return rangeRef(b[0], b[1], b[2], b[3]), true
+		}
+	}
+	return "", false
+}
+
+// mergedOrigins maps the covered positions (col, row) of the
+// merged ranges to the first cell of their range.
+func (sheet *Sheet) mergedOrigins() map[[2]int]*xlsx.Cell {
+	origins := make(map[[2]int]*xlsx.Cell)
+	for _, b := range sheet.mergedBounds() {
+		first := sheet.Rows[b[1]-1].Cells[b[0]-1]
+		for r := b[1]; r <= b[3]; r++ {
+			for c := b[0]; c <= b[2]; c++ {
+				if r != b[1] || c != b[0] {
+					origins[[2]int{c, r}] = first
+				}
+			}
+		}
+	}
+	return origins
+}
+
+// CellRangeMerged returns all cells by row of a range like
+// CellRange, but positions covered by a merged range give the
+// first cell of that range, which holds the value. The sheet
+// is not changed.
+func (sheet *Sheet) CellRangeMerged(rg string) (
+	[][]*xlsx.Cell, error) {
+	cells, err := sheet.CellRange(rg)
+	if err != nil {
+		return nil, fmt.Errorf("CellRangeMerged: %w", err)
+	}
+	rg, _ = sheet.resolve(rg)
+	minCol, minRow, _, _, _ := sheet.Bounds(rg)
+	origins := sheet.mergedOrigins()
+	for r, row := range cells {
+		for c := range row {
+			pos := [2]int{minCol + c, minRow + r}
+			if first, ok := origins[pos]; ok {
+				row[c] = first
+			}
+		}
+	}
+	return cells, nil
+}
+
+// RowMerged returns a one based row for reading with Col, in
+// which positions covered by a merged range give the first
+// cell of that range. The sheet is not changed: adding cells
+// to the returned row has no effect.
+func (sheet *Sheet) RowMerged(row int) *Row {
+	r := sheet.Rows[row-1]
+	cells := append([]*xlsx.Cell(nil), r.Cells...)
+	for pos, first := range sheet.mergedOrigins() {
+		if pos[1] != row {
+			continue
+		}
+		for len(cells) < pos[0] {
+			cells = append(cells, &xlsx.Cell{Row: r})
+		}
+		cells[pos[0]-1] = first
+	}
+	view := *r
+	view.Cells = cells
+	return &Row{Row: &view, num: row}
+}
+
 // Sheets converts slice of xlsx.Sheet into Sheet
 func Sheets(sheets []*xlsx.Sheet) []*Sheet {
 	s := make([]*Sheet, len(sheets))
@@ -1,6 +1,7 @@
 package xlsxtra_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -186,3 +187,223 @@ func TestSheet_CellRange(t *testing.T) {
 		t.Fatal("Expected error as column C is out of range")
 	}
 }
+
+func TestSheet_Bounds(t *testing.T) {
+	sheet := newSheetUtils()
+	for rg, want := range map[string][4]int{
+		"A:B":     {1, 1, 2, 2},
+		"$B:$Z":   {2, 1, 2, 2},
+		"2:9":     {1, 2, 2, 2},
+		"A1:B2":   {1, 1, 2, 2},
+		"$1:$1":   {1, 1, 2, 1},
+		"B2:B2":   {2, 2, 2, 2},
+		"$A$1:B1": {1, 1, 2, 1},
+	} {
+		minCol, minRow, maxCol, maxRow, err := sheet.Bounds(rg)
+		got := [4]int{minCol, minRow, maxCol, maxRow}
+		if err != nil || got != want {
+			t.Errorf("Bounds(%q): got %v, %v; want %v",
+				rg, got, err, want)
+		}
+	}
+	for _, rg := range []string{"B:A", "2:1", "0:1", "A", "1"} {
+		if _, _, _, _, err := sheet.Bounds(rg); err == nil {
+			t.Errorf("Bounds(%q): expected error", rg)
+		}
+	}
+	for _, rg := range []string{"C:C", "AA:AB", "3:9"} {
+		_, _, _, _, err := sheet.Bounds(rg)
+		if !errors.Is(err, xlsxtra.ErrOutOfRange) {
+			t.Errorf("Bounds(%q): got %v", rg, err)
+		}
+	}
+	cells, err := sheet.CellRange("C:D")
+	if err != nil || len(cells) != 0 {
+		t.Fatalf("CellRange: got %v, %v", cells, err)
+	}
+	cells, err = sheet.CellRange("'Sheet'!2:2")
+	if err != nil || len(cells) != 1 || len(cells[0]) != 2 {
+		t.Fatalf("CellRange: got %v, %v", cells, err)
+	}
+	_, err = sheet.CellRange("Other!A1")
+	if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+		t.Fatalf("CellRange: got %v", err)
+	}
+}
+
+func TestSheet_CellRange_ragged(t *testing.T) {
+	sheet, err := xlsxtra.NewFile().AddSheet("Sheet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("Name", "Price", "Note")
+	sheet.AddRow().AddString("pen", "1.50")
+	_, err = sheet.CellRange("A1:C2")
+	if !errors.Is(err, xlsxtra.ErrOutOfRange) {
+		t.Errorf("CellRange: got %v", err)
+	}
+	for _, rg := range []string{"A:C", "2:2", "B:C"} {
+		cells, err := sheet.CellRange(rg)
+		if err != nil {
+			t.Fatalf("CellRange(%q): %v", rg, err)
+		}
+		last := cells[len(cells)-1]
+		if got := xlsxtra.ToString(last); got[len(got)-1] != "" {
+			t.Errorf("CellRange(%q): got %v", rg, got)
+		}
+		if _, err = sheet.CellRangeMerged(rg); err != nil {
+			t.Fatalf("CellRangeMerged(%q): %v", rg, err)
+		}
+	}
+	// the sheet is not changed
+	if n := len(sheet.Rows[1].Cells); n != 2 {
+		t.Errorf("CellRange: got %d cells in row 2", n)
+	}
+	_, err = sheet.CellRange("B2:A1")
+	if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+		t.Errorf("CellRange: got %v", err)
+	}
+}
+
+func ExampleSheet_Hyperlink() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Orders")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	row := sheet.AddRow()
+	row.AddHyperlink("Order 1", "https://example.com/orders/1")
+	row.AddString("Summary", "Mail")
+	for coord, target := range map[string]string{
+		"B1": "Summary!A1",
+		"C1": "mailto:orders@example.com",
+	} {
+		if err = sheet.SetHyperlink(coord, target); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	for _, cell := range row.Cells {
+		fmt.Println(cell.Formula())
+	}
+	for _, coord := range []string{"A1", "B1", "C1"} {
+		fmt.Println(sheet.Hyperlink(coord))
+	}
+	// Output:
+	// HYPERLINK("https://example.com/orders/1","Order 1")
+	// HYPERLINK("#Summary!A1","Summary")
+	// HYPERLINK("mailto:orders@example.com","Mail")
+	// https://example.com/orders/1 <nil>
+	// Summary!A1 <nil>
+	// mailto:orders@example.com <nil>
+}
+
+func TestSheet_Hyperlink(t *testing.T) {
+	f := xlsxtra.NewFile()
+	sheet, err := f.AddSheet("Orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("id", "status")
+	row := sheet.AddRow()
+	row.AddHyperlink("1001", "https://example.com/orders/1001")
+	row.AddString("shipped")
+	if err = sheet.SetHyperlink("B2", "Summary!A1"); err != nil {
+		t.Fatal(err)
+	}
+	c, err := f.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied, err := c.SheetByName("Orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	col := xlsxtra.NewCol(copied, 1)
+	for header, want := range map[string]string{
+		"id": "1001", "status": "shipped"} {
+		got, err := col.String(copied.Row(2), header)
+		if err != nil || got != want {
+			t.Errorf("String(%q): got %q, %v; want %q",
+				header, got, err, want)
+		}
+	}
+	target, err := copied.Hyperlink("A2")
+	if err != nil || target != "https://example.com/orders/1001" {
+		t.Fatalf("Hyperlink: got %q, %v", target, err)
+	}
+}
+
+func ExampleSheet_Merge() {
+	sheet := newSheetUtils()
+	err := sheet.Merge("A1:B1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(sheet.Merge("B1:C3"))
+	fmt.Println(sheet.Merge("A3:B4"))
+	fmt.Println(sheet.MergedRanges())
+	fmt.Println(sheet.MergedAt("B4"))
+	fmt.Println(sheet.MergedAt("A2"))
+	fmt.Println(sheet.Unmerge("A3:B4"))
+	fmt.Println(sheet.Unmerge("A3:B4"))
+	fmt.Println(sheet.MergedRanges())
+	// Output:
+	// Merge: range "B1:C3" overlaps "A1:B1"
+	// <nil>
+	// [A1:B1 A3:B4]
+	// A3:B4 true
+	//  false
+	// <nil>
+	// Unmerge: range "A3:B4" is not merged
+	// [A1:B1]
+}
+
+func TestSheet_CellRangeMerged(t *testing.T) {
+	sheet := newSheetUtils()
+	for _, rg := range []string{"A1", "A0:B1", "B2:A1"} {
+		if err := sheet.Merge(rg); err == nil {
+			t.Fatalf("Merge(%q): expected error", rg)
+		}
+	}
+	err := sheet.Merge("A1:A2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cells, err := sheet.CellRangeMerged("A1:B2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := xlsxtra.ToString(cells[1])
+	if fmt.Sprint(got) != "[A1 B2]" {
+		t.Fatalf("CellRangeMerged: got %q", got)
+	}
+	if sheet.Rows[1].Cells[0].Value != "A2" ||
+		len(sheet.MergedRanges()) != 1 {
+		t.Fatal("CellRangeMerged: sheet changed")
+	}
+	if err = sheet.Merge("C1:D2"); err != nil {
+		t.Fatal(err)
+	}
+	sheet.Rows[0].Cells[2].SetString("banner")
+	col := xlsxtra.NewCol(sheet, 1)
+	row := sheet.RowMerged(2)
+	for header, want := range map[string]string{
+		"A1": "A1", "B1": "B2", "banner": "banner"} {
+		s, err := col.String(row, header)
+		if err != nil || s != want {
+			t.Errorf("String(%q): got %q, %v; want %q",
+				header, s, err, want)
+		}
+	}
+	_, err = col.Int(row, "A1")
+	var perr *xlsxtra.ParseError
+	if !errors.As(err, &perr) || perr.Coord != "A2" {
+		t.Fatalf("Int: got %v; want parse error of A2", err)
+	}
+	if len(sheet.Rows[1].Cells) != 4 ||
+		sheet.Rows[1].Cells[0].Value != "A2" {
+		t.Fatal("RowMerged: sheet changed")
+	}
+}
@@ -3,6 +3,7 @@ package xlsxtra
 import (
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/tealeg/xlsx"
@@ -80,12 +81,20 @@ func (m *MultiColumnSort) Swap(i, j int) {
 }
 
 // get retrieves value by column index, returns empty
-// string if doesn't exist.
+// string if doesn't exist. Numbers are normalized, for text
+// cells with Numbers.
 func get(row *xlsx.Row, col int) string {
 	if col < len(row.Cells) {
-		s, _ := row.Cells[col].String()
-		if strings.HasPrefix(s, "€") || strings.HasPrefix(s, "$") {
-			s = strings.TrimLeft(s, "€$ ")
+		cell := row.Cells[col]
+		if isNumeric(cell) {
+			f, err := strconv.ParseFloat(cell.Value, 64)
+			if err == nil {
+				return strconv.FormatFloat(f, 'f', -1, 64)
+			}
+		}
+		s, _ := cell.String()
+		if f, err := Numbers.Parse(s); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
 		}
 		return s
 	}
@@ -0,0 +1,119 @@
+package xlsxtra
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Number formats used by Row.AddDate, Row.AddTime and
+// Row.AddDuration
+var (
+	DateFormat     = "yyyy-mm-dd"
+	TimeFormat     = "yyyy-mm-dd hh:mm:ss"
+	DurationFormat = "[h]:mm:ss"
+)
+
+// TimeLayouts are tried in order by ParseTime to parse a
+// textual date.
+var TimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"02-01-2006 15:04:05",
+	"02-01-2006",
+	"2-1-2006",
+	"02.01.2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+}
+
+var (
+	reFmtLiteral  = regexp.MustCompile(`"[^"]*"|\\.|\[[^\]]*\]`)
+	reFmtDuration = regexp.MustCompile(`^\[(h+|m+|s+)\]`)
+)
+
+const nsPerDay = 24 * 60 * 60 * 1e9
+
+var (
+	epoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	epoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ExcelTime converts a time into an excel serial number in
+// the 1900 or 1904 date system. The time zone is ignored:
+// the serial represents the clock time of t. (In the 1900
+// date system, dates before 1 March 1900 are shifted by one
+// day to skip the non-existing 29 February 1900 of excel.)
+func ExcelTime(t time.Time, date1904 bool) float64 {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0,
+		time.UTC)
+	// whole days and the time of day separately, as a
+	// time.Duration only spans about 292 years
+	frac := float64(t.Hour())/24 + float64(t.Minute())/1440 +
+		(float64(t.Second())+float64(t.Nanosecond())/1e9)/86400
+	epoch := epoch1900
+	if date1904 {
+		epoch = epoch1904
+	}
+	serial := float64((day.Unix()-epoch.Unix())/86400) + frac
+	if !date1904 && serial < 61 {
+		serial--
+	}
+	return serial
+}
+
+// FromExcelTime converts an excel serial number in the 1900
+// or 1904 date system into a time in UTC. (The non-existing
+// 29 February 1900 of the 1900 date system is returned as 1
+// March 1900.)
+func FromExcelTime(serial float64, date1904 bool) time.Time {
+	epoch := epoch1900
+	if date1904 {
+		epoch = epoch1904
+	} else if serial < 61 {
+		epoch = epoch.AddDate(0, 0, 1)
+	}
+	// whole days and the time of day separately, as a
+	// time.Duration only spans about 292 years
+	days := math.Floor(serial)
+	// round to milliseconds to avoid floating point noise
+	ms := math.Round((serial - days) * nsPerDay / 1e6)
+	return epoch.AddDate(0, 0, int(days)).Add(
+		time.Duration(ms) * time.Millisecond)
+}
+
+// ParseTime parses a textual date with the first matching
+// layout of TimeLayouts.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range TimeLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("ParseTime: unknown date format %q",
+		s)
+}
+
+// isDateFormat checks if a number format displays a date or
+// a time.
+func isDateFormat(format string) bool {
+	format = reFmtLiteral.ReplaceAllString(
+		strings.ToLower(format), "")
+	return strings.ContainsAny(format, "ydhs")
+}
+
+// isDurationFormat checks if a number format displays
+// elapsed time, such as "[h]:mm:ss".
+func isDurationFormat(format string) bool {
+	return reFmtDuration.MatchString(strings.ToLower(format))
+}
@@ -0,0 +1,76 @@
+package xlsxtra_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleFromExcelTime() {
+	fmt.Println(xlsxtra.FromExcelTime(1, false))
+	fmt.Println(xlsxtra.FromExcelTime(59, false))
+	fmt.Println(xlsxtra.FromExcelTime(61, false))
+	fmt.Println(xlsxtra.FromExcelTime(42736.5, false))
+	fmt.Println(xlsxtra.FromExcelTime(41274.5, true))
+	// Output:
+	// 1900-01-01 00:00:00 +0000 UTC
+	// 1900-02-28 00:00:00 +0000 UTC
+	// 1900-03-01 00:00:00 +0000 UTC
+	// 2017-01-01 12:00:00 +0000 UTC
+	// 2017-01-01 12:00:00 +0000 UTC
+}
+
+func TestExcelTime(t *testing.T) {
+	for _, date1904 := range []bool{false, true} {
+		for _, want := range []time.Time{
+			time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1900, 2, 28, 6, 0, 0, 0, time.UTC),
+			time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC),
+			time.Date(2500, 6, 15, 18, 30, 0, 0, time.UTC),
+			time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		} {
+			serial := xlsxtra.ExcelTime(want, date1904)
+			got := xlsxtra.FromExcelTime(serial, date1904)
+			if !got.Equal(want) {
+				t.Fatalf("got %v; want %v (serial %v, 1904 %v)",
+					got, want, serial, date1904)
+			}
+		}
+	}
+	got := xlsxtra.ExcelTime(
+		time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), false)
+	if got != 59 {
+		t.Fatalf("got serial %v; want 59", got)
+	}
+	got = xlsxtra.ExcelTime(
+		time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), false)
+	if got != 2958465 {
+		t.Fatalf("got serial %v; want 2958465", got)
+	}
+	want := time.Date(2447, 7, 30, 0, 0, 0, 0, time.UTC)
+	if got := xlsxtra.FromExcelTime(200000, false); !got.Equal(want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC)
+	for _, s := range []string{
+		"2017-01-31", "31-01-2017", "31 Jan 2017",
+		"January 31, 2017"} {
+		got, err := xlsxtra.ParseTime(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("%q: got %v; want %v", s, got, want)
+		}
+	}
+	_, err := xlsxtra.ParseTime("not a date")
+	if err == nil {
+		t.Fatal("ParseTime: expected error")
+	}
+}
@@ -24,6 +24,11 @@ var (
 		`^[$]?([A-Z]+)[$]?([1-9]\d*)$`)
 	reRange = regexp.MustCompile(
 		fmt.Sprintf("^%s$", rangeExpr))
+	reColRange = regexp.MustCompile(`^[$]?([A-Z]+):[$]?([A-Z]+)$`)
+	reRowRange = regexp.MustCompile(
+		`^[$]?([1-9]\d*):[$]?([1-9]\d*)$`)
+	reHyperlink = regexp.MustCompile(
+		`^=?HYPERLINK\(\s*"((?:[^"]|"")*)"`)
 )
 
 // ColRange gives a range of intervals.
@@ -47,8 +52,7 @@ func SplitCoord(coord string) (string, int, error) {
 	m := reCoord.FindStringSubmatch(coord)
 	if m == nil {
 		return "", 0,
-			fmt.Errorf("SplitCoord: Invalid cell coordinates %q",
-				coord)
+			fmt.Errorf("SplitCoord: %w", &CoordError{Ref: coord})
 	}
 	column, rowStr := m[1], m[2]
 	row, _ := strconv.Atoi(rowStr)
@@ -76,7 +80,7 @@ func colStr(i int) string {
 			i--
 		}
 		letters = append(
-			[]string{string(mod + 64)}, letters...)
+			[]string{string(rune(mod + 64))}, letters...)
 	}
 	return strings.Join(letters, "")
 }
@@ -102,7 +106,7 @@ func Abs(s string) string {
 func RangeBounds(rg string) (int, int, int, int, error) {
 	m := reRange.FindStringSubmatch(rg)
 	if m == nil {
-		return 0, 0, 0, 0, fmt.Errorf("Invalid range %q", rg)
+		return 0, 0, 0, 0, &CoordError{Ref: rg, Range: true}
 	}
 	minCol := StrCol[m[1]]
 	minRow, _ := strconv.Atoi(m[2])
@@ -117,6 +121,50 @@ func RangeBounds(rg string) (int, int, int, int, error) {
 	return minCol, minRow, maxCol, maxRow, nil
 }
 
+// rangeRef converts boundaries into a range string. (A
+// single cell is returned as a cell coordinate.)
+func rangeRef(minCol, minRow, maxCol, maxRow int) string {
+	if minCol == maxCol && minRow == maxRow {
+		return Coord(minCol, minRow)
+	}
+	return Coord(minCol, minRow) + ":" + Coord(maxCol, maxRow)
+}
+
+// splitSheetRef splits a reference such as "'Q1 Data'!A1:B9"
+// into the sheet name and the range. (The sheet name is empty
+// if the reference has none.)
+func splitSheetRef(ref string) (string, string) {
+	ref = strings.TrimPrefix(ref, "=")
+	i := strings.LastIndex(ref, "!")
+	if i < 0 {
+		return "", ref
+	}
+	sheet := ref[:i]
+	if len(sheet) > 1 && strings.HasPrefix(sheet, "'") &&
+		strings.HasSuffix(sheet, "'") {
+		sheet = strings.ReplaceAll(sheet[1:len(sheet)-1], "''", "'")
+	}
+	return sheet, ref[i+1:]
+}
+
+// splitAreas splits a multi-area reference such as
+// "A1:B2,D4:E5" into its areas. Commas in quoted sheet names
+// are kept.
+func splitAreas(ref string) []string {
+	var areas []string
+	quoted, start := false, 0
+	for i, r := range ref {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			areas = append(areas, strings.TrimSpace(ref[start:i]))
+			start = i + 1
+		}
+	}
+	return append(areas, strings.TrimSpace(ref[start:]))
+}
+
 // Transpose rows into columns and vice versa
 func Transpose(cells [][]*xlsx.Cell) [][]*xlsx.Cell {
 	rows := len(cells)
@@ -148,3 +196,30 @@ func CoordAbs(col, row int) string {
 	c := ColStr[col]
 	return Abs(fmt.Sprintf("%s%d", c, row))
 }
+
+// HyperlinkFormula returns a HYPERLINK formula for a target
+// and text. The target is an url, a mailto link or a
+// reference to a sheet and cell, such as "Sheet2!A1".
+func HyperlinkFormula(target, text string) string {
+	if strings.Contains(target, "!") &&
+		!strings.Contains(target, ":/") &&
+		!strings.HasPrefix(target, "#") {
+		target = "#" + target
+	}
+	quote := func(s string) string {
+		return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+	}
+	return fmt.Sprintf("HYPERLINK(%s,%s)", quote(target),
+		quote(text))
+}
+
+// HyperlinkTarget returns the target of a HYPERLINK formula
+// or an empty string if it is not a hyperlink.
+func HyperlinkTarget(formula string) string {
+	m := reHyperlink.FindStringSubmatch(formula)
+	if m == nil {
+		return ""
+	}
+	target := strings.Replace(m[1], `""`, `"`, -1)
+	return strings.TrimPrefix(target, "#")
+}
@@ -84,3 +84,19 @@ func ExampleCoordAbs() {
 	// $B$12
 	// ?12
 }
+
+func TestHyperlink(t *testing.T) {
+	formula := xlsxtra.HyperlinkFormula(
+		`'Q1 "Data"'!B2`, `say "hi"`)
+	want := `HYPERLINK("#'Q1 ""Data""'!B2","say ""hi""")`
+	if formula != want {
+		t.Fatalf("got %s; want %s", formula, want)
+	}
+	target := xlsxtra.HyperlinkTarget("=" + formula)
+	if target != `'Q1 "Data"'!B2` {
+		t.Fatalf("got target %s", target)
+	}
+	if xlsxtra.HyperlinkTarget("SUM(A1:A2)") != "" {
+		t.Fatal("expected no target for SUM")
+	}
+}
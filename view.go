@@ -0,0 +1,72 @@
+package xlsxtra
+
+import (
+	"fmt"
+
+	"github.com/tealeg/xlsx"
+)
+
+// FreezePanes freezes the rows above and the columns left of
+// a cell, so they stay visible while scrolling. For example
+// "B2" freezes the header row and the first column, "A2" only
+// the header row. "A1" removes frozen or split panes.
+func (sheet *Sheet) FreezePanes(coord string) error {
+	colStr, row, err := SplitCoord(coord)
+	if err != nil {
+		return fmt.Errorf("FreezePanes: %w",
+			withSheet(err, sheet.Name))
+	}
+	col, ok := StrCol[colStr]
+	if !ok {
+		return fmt.Errorf("FreezePanes: column overflow: %w",
+			&CoordError{Sheet: sheet.Name, Ref: coord})
+	}
+	sheet.setPane(float64(col-1), float64(row-1),
+		Coord(col, row), "frozen")
+	return nil
+}
+
+// SplitPanes splits the window in panes which scroll
+// separately. The horizontal and vertical position of the
+// split are in twips (1/20 of a point); zero means no split
+// in that direction. topLeft is the first visible cell of the
+// bottom right pane.
+func (sheet *Sheet) SplitPanes(x, y float64, topLeft string) error {
+	colStr, row, err := SplitCoord(topLeft)
+	if err != nil {
+		return fmt.Errorf("SplitPanes: %w",
+			withSheet(err, sheet.Name))
+	}
+	col, ok := StrCol[colStr]
+	if !ok {
+		return fmt.Errorf("SplitPanes: column overflow: %w",
+			&CoordError{Sheet: sheet.Name, Ref: topLeft})
+	}
+	sheet.setPane(x, y, Coord(col, row), "split")
+	return nil
+}
+
+// setPane sets the pane of the sheet view. Without a split
+// the pane is removed.
+func (sheet *Sheet) setPane(x, y float64, topLeft,
+	state string) {
+	var pane *xlsx.Pane
+	if x > 0 || y > 0 {
+		active := "bottomRight"
+		switch {
+		case x == 0:
+			active = "bottomLeft"
+		case y == 0:
+			active = "topRight"
+		}
+		pane = &xlsx.Pane{
+			XSplit:      x,
+			YSplit:      y,
+			TopLeftCell: topLeft,
+			ActivePane:  active,
+			State:       state,
+		}
+	}
+	// the xlsx package writes only one sheet view
+	sheet.SheetViews = []xlsx.SheetView{{Pane: pane}}
+}
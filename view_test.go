@@ -0,0 +1,80 @@
+package xlsxtra_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stanim/xlsxtra"
+)
+
+func ExampleSheet_FreezePanes() {
+	sheet, err := xlsxtra.NewFile().AddSheet("Report")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sheet.AddRow().AddString("name", "amount")
+	sheet.AddRow().AddString("cookies")
+	// keep header row and first column visible
+	err = sheet.FreezePanes("B2")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	pane := sheet.SheetViews[0].Pane
+	fmt.Println(pane.XSplit, pane.YSplit, pane.TopLeftCell,
+		pane.ActivePane, pane.State)
+	// Output:
+	// 1 1 B2 bottomRight frozen
+}
+
+func TestSheet_FreezePanes(t *testing.T) {
+	f := xlsxtra.NewFile()
+	sheet, err := f.AddSheet("Report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.AddRow().AddString("name", "amount")
+	if err = sheet.FreezePanes("$A$2"); err != nil {
+		t.Fatal(err)
+	}
+	c, err := f.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	views := c.Sheets[0].SheetViews
+	if len(views) != 1 || views[0].Pane == nil {
+		t.Fatalf("FreezePanes: got %v", views)
+	}
+	pane := *views[0].Pane
+	if pane.XSplit != 0 || pane.YSplit != 1 ||
+		pane.TopLeftCell != "A2" || pane.ActivePane != "bottomLeft" ||
+		pane.State != "frozen" {
+		t.Fatalf("FreezePanes: got %+v", pane)
+	}
+	if err = sheet.SplitPanes(1500, 0, "C1"); err != nil {
+		t.Fatal(err)
+	}
+	pane = *sheet.SheetViews[0].Pane
+	if pane.XSplit != 1500 || pane.ActivePane != "topRight" ||
+		pane.State != "split" {
+		t.Fatalf("SplitPanes: got %+v", pane)
+	}
+	if err = sheet.FreezePanes("A1"); err != nil {
+		t.Fatal(err)
+	}
+	if sheet.SheetViews[0].Pane != nil {
+		t.Fatalf("FreezePanes: pane not removed")
+	}
+	for _, coord := range []string{"B", "ZZZZ2"} {
+		err = sheet.FreezePanes(coord)
+		if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+			t.Fatalf("FreezePanes(%q): got %v", coord, err)
+		}
+	}
+	err = sheet.SplitPanes(1500, 0, "ZZZZ1")
+	if !errors.Is(err, xlsxtra.ErrInvalidCoord) {
+		t.Fatalf("SplitPanes: got %v", err)
+	}
+}
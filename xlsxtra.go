@@ -21,5 +21,14 @@
 //
 // - ToString: convert a xlsx.Row to a slice of strings
 //
+// - NumberParser: parse numbers with thousands separators,
+// currencies, percentages and accounting negatives
+//
+// - Schema: validate the columns of a sheet and Highlight the
+// violations
+//
+// - FreezePanes: keep header rows and columns visible while
+// scrolling
+//
 // See Col(umn) and Sort example for a quick introduction.
 package xlsxtra